@@ -2,18 +2,24 @@ package main
 
 import (
 	"context"
+	"flag"
 	"fmt"
 	"log"
 	"log/slog"
 	"net/http"
 	"os"
 	"os/signal"
+	"strings"
 	"syscall"
 
 	"github.com/EduardoMG12/cine/api_v2/internal/config"
+	"github.com/EduardoMG12/cine/api_v2/internal/domain"
 	"github.com/EduardoMG12/cine/api_v2/internal/middleware"
+	"github.com/EduardoMG12/cine/api_v2/internal/repository"
 	"github.com/EduardoMG12/cine/api_v2/internal/server"
+	"github.com/jmoiron/sqlx"
 	"github.com/joho/godotenv"
+	_ "github.com/lib/pq"
 )
 
 const banner = `
@@ -46,6 +52,15 @@ func main() {
 	logger := middleware.SetupLogger(cfg.Server.Environment)
 	slog.SetDefault(logger)
 
+	// Run a one-off command instead of the server, e.g. `main bootstrap-admin --email admin@example.com`
+	if len(os.Args) > 1 {
+		if err := runCommand(cfg, os.Args[1], os.Args[2:]); err != nil {
+			logger.Error("Command failed", "command", os.Args[1], "error", err)
+			os.Exit(1)
+		}
+		return
+	}
+
 	// Create server
 	srv, err := server.NewServer(cfg, logger)
 	if err != nil {
@@ -84,3 +99,61 @@ func main() {
 
 	logger.Info("✅ Server stopped successfully")
 }
+
+func runCommand(cfg *config.Config, name string, args []string) error {
+	switch name {
+	case "bootstrap-admin":
+		return runBootstrapAdmin(cfg, args)
+	default:
+		return fmt.Errorf("unknown command %q (available: bootstrap-admin)", name)
+	}
+}
+
+// runBootstrapAdmin promotes a registered account to admin.
+// Once an admin exists it refuses to run without --force, further admins are promoted through the API.
+func runBootstrapAdmin(cfg *config.Config, args []string) error {
+	flags := flag.NewFlagSet("bootstrap-admin", flag.ContinueOnError)
+	email := flags.String("email", "", "email of the registered account to promote")
+	force := flags.Bool("force", false, "promote the account even if an admin already exists")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	if *email == "" {
+		return fmt.Errorf("--email is required")
+	}
+
+	db, err := sqlx.Connect("postgres", cfg.Database.GetDSN())
+	if err != nil {
+		return fmt.Errorf("failed to connect to database: %w", err)
+	}
+	defer db.Close()
+
+	ctx := context.Background()
+	userRepo := repository.NewUserRepository(repository.NewDB(db, cfg.Database.QueryTimeout))
+
+	admins, err := userRepo.CountUsersByRole(ctx, domain.RoleAdmin)
+	if err != nil {
+		return err
+	}
+	if admins > 0 && !*force {
+		return fmt.Errorf("an admin already exists, use --force to promote another account")
+	}
+
+	user, err := userRepo.GetUserByEmail(ctx, strings.ToLower(strings.TrimSpace(*email)))
+	if err != nil {
+		return fmt.Errorf("no account registered with %s, register it first", *email)
+	}
+
+	if err := userRepo.UpdateUserRole(ctx, user.ID, domain.RoleAdmin); err != nil {
+		return err
+	}
+	if user.Disabled() {
+		if err := userRepo.SetUserDisabled(ctx, user.ID, nil); err != nil {
+			return err
+		}
+	}
+
+	fmt.Printf("✅ %s (%s) is now an admin\n", user.Username, user.Email)
+	return nil
+}
@@ -3,8 +3,10 @@ package config
 import (
 	"fmt"
 	"log"
+	"net"
 	"os"
 	"strconv"
+	"strings"
 	"time"
 )
 
@@ -12,8 +14,12 @@ type Config struct {
 	Server   ServerConfig   `json:"server"`
 	Database DatabaseConfig `json:"database"`
 	JWT      JWTConfig      `json:"jwt"`
+	Auth     AuthConfig     `json:"auth"`
 	OMDb     OMDbConfig     `json:"omdb"`
+	TMDb     TMDbConfig     `json:"tmdb"`
+	Movies   MoviesConfig   `json:"movies"`
 	Redis    RedisConfig    `json:"redis"`
+	Mail     MailConfig     `json:"mail"`
 }
 
 type ServerConfig struct {
@@ -23,30 +29,101 @@ type ServerConfig struct {
 	ReadTimeout     time.Duration `json:"read_timeout"`
 	WriteTimeout    time.Duration `json:"write_timeout"`
 	ShutdownTimeout time.Duration `json:"shutdown_timeout"`
+	RequestTimeout  time.Duration `json:"request_timeout"` // deadline of every request context
+	// Proxies (IPs or CIDRs) whose X-Forwarded-For / X-Real-IP headers are trusted for the client IP
+	TrustedProxies []string `json:"trusted_proxies"`
 }
 
 type DatabaseConfig struct {
-	Host            string `json:"host"`
-	Port            int    `json:"port"`
-	Name            string `json:"name"`
-	User            string `json:"user"`
-	Password        string `json:"password"`
-	SSLMode         string `json:"ssl_mode"`
-	MaxOpenConns    int    `json:"max_open_conns"`
-	MaxIdleConns    int    `json:"max_idle_conns"`
-	ConnMaxLifetime int    `json:"conn_max_lifetime"` // in minutes
+	Host            string        `json:"host"`
+	Port            int           `json:"port"`
+	Name            string        `json:"name"`
+	User            string        `json:"user"`
+	Password        string        `json:"password"`
+	SSLMode         string        `json:"ssl_mode"`
+	MaxOpenConns    int           `json:"max_open_conns"`
+	MaxIdleConns    int           `json:"max_idle_conns"`
+	ConnMaxLifetime int           `json:"conn_max_lifetime"` // in minutes
+	QueryTimeout    time.Duration `json:"query_timeout"`
 }
 
 type JWTConfig struct {
-	Secret         string        `json:"-"`
-	ExpirationTime time.Duration `json:"expiration_time"`
-	RefreshTime    time.Duration `json:"refresh_time"`
-	Issuer         string        `json:"issuer"`
+	KeysDir             string        `json:"keys_dir"`
+	Algorithm           string        `json:"algorithm"`
+	KeyRotationInterval time.Duration `json:"key_rotation_interval"`
+	KeyGracePeriod      time.Duration `json:"key_grace_period"`
+	ExpirationTime      time.Duration `json:"expiration_time"`
+	RefreshTime         time.Duration `json:"refresh_time"`
+	Issuer              string        `json:"issuer"`
+}
+
+type AuthConfig struct {
+	SessionCacheTTL                time.Duration `json:"session_cache_ttl"`
+	PasswordResetTTL               time.Duration `json:"password_reset_ttl"`
+	PasswordResetCooldown          time.Duration `json:"password_reset_cooldown"`
+	PasswordResetHourlyLimit       int           `json:"password_reset_hourly_limit"`
+	EmailVerificationTTL           time.Duration `json:"email_verification_ttl"`
+	EmailVerificationCooldown      time.Duration `json:"email_verification_cooldown"`
+	EmailVerificationHourlyLimit   int           `json:"email_verification_hourly_limit"`
+	EmailChangeTTL                 time.Duration `json:"email_change_ttl"`
+	AccountDeletionGracePeriod     time.Duration `json:"account_deletion_grace_period"`
+	AccountPurgeInterval           time.Duration `json:"account_purge_interval"`
+	UsernameChangeCooldown         time.Duration `json:"username_change_cooldown"`
+	UsernameReservationPeriod      time.Duration `json:"username_reservation_period"`
+	RequireVerifiedEmailForWriting bool          `json:"require_verified_email_for_writing"`
+	MFAIssuer                      string        `json:"mfa_issuer"`
+	MFAChallengeTTL                time.Duration `json:"mfa_challenge_ttl"`
+	LoginMaxFailuresPerEmail       int           `json:"login_max_failures_per_email"`
+	LoginMaxFailuresPerIP          int           `json:"login_max_failures_per_ip"`
+	LoginFailureWindow             time.Duration `json:"login_failure_window"`
+	LoginLockoutBase               time.Duration `json:"login_lockout_base"`
+	LoginLockoutMax                time.Duration `json:"login_lockout_max"`
 }
 
 type OMDbConfig struct {
-	APIKey  string `json:"-"`
-	BaseURL string `json:"base_url"`
+	APIKey     string        `json:"-"`
+	APIKeys    []string      `json:"-"` // extra keys, calls rotate across all of them
+	DailyLimit int           `json:"daily_limit"`
+	BaseURL    string        `json:"base_url"`
+	Timeout    time.Duration `json:"timeout"`
+}
+
+type TMDbConfig struct {
+	APIKey       string        `json:"-"`
+	BaseURL      string        `json:"base_url"`
+	ImageBaseURL string        `json:"image_base_url"`
+	Language     string        `json:"language"`
+	Timeout      time.Duration `json:"timeout"`
+}
+
+// MoviesConfig configures the movie provider chain
+type MoviesConfig struct {
+	Providers               []string      `json:"providers"` // tried in order: omdb, tmdb, database
+	AutoSave                bool          `json:"auto_save"`
+	BreakerFailureThreshold int           `json:"breaker_failure_threshold"`
+	BreakerOpenDuration     time.Duration `json:"breaker_open_duration"`
+	CacheTTL                time.Duration `json:"cache_ttl"`
+	SearchCacheTTL          time.Duration `json:"search_cache_ttl"`
+	NotFoundCacheTTL        time.Duration `json:"not_found_cache_ttl"`
+	DatabaseFirst           bool          `json:"database_first"`
+	RefreshConcurrency      int           `json:"refresh_concurrency"`
+	FetchTimeout            time.Duration `json:"fetch_timeout"` // deadline of a lookup through the whole chain
+	SeedConcurrency         int           `json:"seed_concurrency"`
+	SeedCooldown            time.Duration `json:"seed_cooldown"`
+	CatalogRefreshInterval  time.Duration `json:"catalog_refresh_interval"`
+	CatalogRefreshBudget    int           `json:"catalog_refresh_budget"` // movies refreshed per hour
+	CatalogRefreshRetry     time.Duration `json:"catalog_refresh_retry"`
+}
+
+type MailConfig struct {
+	Driver       string `json:"driver"` // "outbox" or "smtp"
+	From         string `json:"from"`
+	OutboxDir    string `json:"outbox_dir"`
+	SMTPHost     string `json:"smtp_host"`
+	SMTPPort     string `json:"smtp_port"`
+	SMTPUser     string `json:"smtp_user"`
+	SMTPPassword string `json:"-"`
+	AppURL       string `json:"app_url"` // base URL used in links sent by email
 }
 
 type RedisConfig struct {
@@ -65,6 +142,8 @@ func Load() (*Config, error) {
 			ReadTimeout:     getEnvDuration("READ_TIMEOUT", "15s"),
 			WriteTimeout:    getEnvDuration("WRITE_TIMEOUT", "15s"),
 			ShutdownTimeout: getEnvDuration("SHUTDOWN_TIMEOUT", "30s"),
+			RequestTimeout:  getEnvDuration("REQUEST_TIMEOUT", "60s"),
+			TrustedProxies:  getEnvList("TRUSTED_PROXIES", ""),
 		},
 		Database: DatabaseConfig{
 			Host:            getEnv("DB_HOST", "localhost"),
@@ -76,16 +155,69 @@ func Load() (*Config, error) {
 			MaxOpenConns:    getEnvInt("DB_MAX_OPEN_CONNS", 25),
 			MaxIdleConns:    getEnvInt("DB_MAX_IDLE_CONNS", 10),
 			ConnMaxLifetime: getEnvInt("DB_CONN_MAX_LIFETIME", 5),
+			QueryTimeout:    getEnvDuration("DB_QUERY_TIMEOUT", "5s"),
 		},
 		JWT: JWTConfig{
-			Secret:         getEnv("JWT_SECRET", "your-super-secret-jwt-key-change-this"),
-			ExpirationTime: getEnvDuration("JWT_EXPIRATION", "24h"),
-			RefreshTime:    getEnvDuration("JWT_REFRESH", "7d"),
-			Issuer:         getEnv("JWT_ISSUER", "cineverse-api"),
+			KeysDir:             getEnv("JWT_KEYS_DIR", "keys"),
+			Algorithm:           getEnv("JWT_ALGORITHM", "RS256"),
+			KeyRotationInterval: getEnvDuration("JWT_KEY_ROTATION_INTERVAL", "720h"),
+			KeyGracePeriod:      getEnvDuration("JWT_KEY_GRACE_PERIOD", "24h"),
+			ExpirationTime:      getEnvDuration("JWT_EXPIRATION", "15m"),
+			RefreshTime:         getEnvDuration("JWT_REFRESH", "168h"),
+			Issuer:              getEnv("JWT_ISSUER", "cineverse-api"),
+		},
+		Auth: AuthConfig{
+			SessionCacheTTL:                getEnvDuration("SESSION_CACHE_TTL", "5m"),
+			PasswordResetTTL:               getEnvDuration("PASSWORD_RESET_TTL", "1h"),
+			PasswordResetCooldown:          getEnvDuration("PASSWORD_RESET_COOLDOWN", "1m"),
+			PasswordResetHourlyLimit:       getEnvInt("PASSWORD_RESET_HOURLY_LIMIT", 5),
+			EmailVerificationTTL:           getEnvDuration("EMAIL_VERIFICATION_TTL", "24h"),
+			EmailVerificationCooldown:      getEnvDuration("EMAIL_VERIFICATION_COOLDOWN", "1m"),
+			EmailVerificationHourlyLimit:   getEnvInt("EMAIL_VERIFICATION_HOURLY_LIMIT", 5),
+			EmailChangeTTL:                 getEnvDuration("EMAIL_CHANGE_TTL", "1h"),
+			AccountDeletionGracePeriod:     getEnvDuration("ACCOUNT_DELETION_GRACE_PERIOD", "720h"),
+			AccountPurgeInterval:           getEnvDuration("ACCOUNT_PURGE_INTERVAL", "1h"),
+			UsernameChangeCooldown:         getEnvDuration("USERNAME_CHANGE_COOLDOWN", "720h"),
+			UsernameReservationPeriod:      getEnvDuration("USERNAME_RESERVATION_PERIOD", "2160h"),
+			RequireVerifiedEmailForWriting: getEnvBool("REQUIRE_VERIFIED_EMAIL", false),
+			MFAIssuer:                      getEnv("MFA_ISSUER", "CineVerse"),
+			MFAChallengeTTL:                getEnvDuration("MFA_CHALLENGE_TTL", "5m"),
+			LoginMaxFailuresPerEmail:       getEnvInt("LOGIN_MAX_FAILURES_PER_EMAIL", 5),
+			LoginMaxFailuresPerIP:          getEnvInt("LOGIN_MAX_FAILURES_PER_IP", 20),
+			LoginFailureWindow:             getEnvDuration("LOGIN_FAILURE_WINDOW", "15m"),
+			LoginLockoutBase:               getEnvDuration("LOGIN_LOCKOUT_BASE", "1m"),
+			LoginLockoutMax:                getEnvDuration("LOGIN_LOCKOUT_MAX", "1h"),
 		},
 		OMDb: OMDbConfig{
-			APIKey:  getEnv("OMDB_API_KEY", ""),
-			BaseURL: getEnv("OMDB_BASE_URL", "http://www.omdbapi.com/"),
+			APIKey:     getEnv("OMDB_API_KEY", ""),
+			APIKeys:    getEnvList("OMDB_API_KEYS", ""),
+			DailyLimit: getEnvInt("OMDB_DAILY_LIMIT", 1000),
+			BaseURL:    getEnv("OMDB_BASE_URL", "http://www.omdbapi.com/"),
+			Timeout:    getEnvDuration("OMDB_TIMEOUT", "5s"),
+		},
+		TMDb: TMDbConfig{
+			APIKey:       getEnv("TMDB_API_KEY", ""),
+			BaseURL:      getEnv("TMDB_BASE_URL", "https://api.themoviedb.org/3"),
+			ImageBaseURL: getEnv("TMDB_IMAGE_BASE_URL", "https://image.tmdb.org/t/p"),
+			Language:     getEnv("TMDB_LANGUAGE", "en-US"),
+			Timeout:      getEnvDuration("TMDB_TIMEOUT", "5s"),
+		},
+		Movies: MoviesConfig{
+			Providers:               getEnvList("MOVIE_PROVIDERS", "omdb,tmdb,database"),
+			AutoSave:                getEnvBool("MOVIE_AUTO_SAVE", true),
+			BreakerFailureThreshold: getEnvInt("MOVIE_PROVIDER_FAILURE_THRESHOLD", 5),
+			BreakerOpenDuration:     getEnvDuration("MOVIE_PROVIDER_OPEN_DURATION", "30s"),
+			CacheTTL:                getEnvDuration("MOVIE_CACHE_TTL", "24h"),
+			SearchCacheTTL:          getEnvDuration("MOVIE_SEARCH_CACHE_TTL", "15m"),
+			NotFoundCacheTTL:        getEnvDuration("MOVIE_NOT_FOUND_CACHE_TTL", "10m"),
+			DatabaseFirst:           getEnvBool("MOVIE_DATABASE_FIRST", true),
+			RefreshConcurrency:      getEnvInt("MOVIE_REFRESH_CONCURRENCY", 4),
+			FetchTimeout:            getEnvDuration("MOVIE_FETCH_TIMEOUT", "15s"),
+			SeedConcurrency:         getEnvInt("MOVIE_SEED_CONCURRENCY", 4),
+			SeedCooldown:            getEnvDuration("MOVIE_SEED_COOLDOWN", "1h"),
+			CatalogRefreshInterval:  getEnvDuration("CATALOG_REFRESH_INTERVAL", "10m"),
+			CatalogRefreshBudget:    getEnvInt("CATALOG_REFRESH_HOURLY_BUDGET", 60),
+			CatalogRefreshRetry:     getEnvDuration("CATALOG_REFRESH_RETRY_DELAY", "6h"),
 		},
 		Redis: RedisConfig{
 			Host:     getEnv("REDIS_HOST", "localhost"),
@@ -93,6 +225,16 @@ func Load() (*Config, error) {
 			Password: getEnv("REDIS_PASSWORD", ""),
 			DB:       getEnvInt("REDIS_DB", 0),
 		},
+		Mail: MailConfig{
+			Driver:       getEnv("MAIL_DRIVER", "outbox"),
+			From:         getEnv("MAIL_FROM", "CineVerse <no-reply@cineverse.local>"),
+			OutboxDir:    getEnv("MAIL_OUTBOX_DIR", "tmp/outbox"),
+			SMTPHost:     getEnv("SMTP_HOST", "localhost"),
+			SMTPPort:     getEnv("SMTP_PORT", "587"),
+			SMTPUser:     getEnv("SMTP_USER", ""),
+			SMTPPassword: getEnv("SMTP_PASSWORD", ""),
+			AppURL:       getEnv("APP_URL", "http://localhost:3000"),
+		},
 	}
 
 	return config, config.Validate()
@@ -103,6 +245,12 @@ func (c *Config) Validate() error {
 		return fmt.Errorf("server port is required")
 	}
 
+	for _, proxy := range c.Server.TrustedProxies {
+		if _, _, err := net.ParseCIDR(proxy); err != nil && net.ParseIP(proxy) == nil {
+			return fmt.Errorf("trusted proxy %q must be an IP address or CIDR", proxy)
+		}
+	}
+
 	if c.Database.Host == "" {
 		return fmt.Errorf("database host is required")
 	}
@@ -111,11 +259,25 @@ func (c *Config) Validate() error {
 		return fmt.Errorf("database name is required")
 	}
 
-	if c.JWT.Secret == "" || c.JWT.Secret == "your-super-secret-jwt-key-change-this" {
-		log.Println("WARNING: Using default JWT secret. Set JWT_SECRET environment variable for production!")
+	if c.JWT.Algorithm != "RS256" && c.JWT.Algorithm != "EdDSA" {
+		return fmt.Errorf("JWT algorithm must be RS256 or EdDSA")
+	}
+
+	// Tokens signed right before a rotation must stay verifiable until they expire
+	if c.JWT.KeyGracePeriod < c.JWT.ExpirationTime {
+		return fmt.Errorf("JWT key grace period must be at least the access token expiration")
 	}
 
-	if c.OMDb.APIKey == "" {
+	if len(c.Movies.Providers) == 0 {
+		return fmt.Errorf("at least one movie provider is required")
+	}
+	for _, provider := range c.Movies.Providers {
+		if provider != "omdb" && provider != "tmdb" && provider != "database" {
+			return fmt.Errorf("unknown movie provider: %s", provider)
+		}
+	}
+
+	if len(c.OMDb.Keys()) == 0 {
 		log.Println("WARNING: OMDb API key not configured. Using default key for testing only!")
 	}
 
@@ -129,6 +291,19 @@ func (c *DatabaseConfig) GetDSN() string {
 	)
 }
 
+// Keys returns every configured OMDb API key without duplicates, OMDB_API_KEY first
+func (c *OMDbConfig) Keys() []string {
+	keys := make([]string, 0, len(c.APIKeys)+1)
+	seen := map[string]bool{}
+	for _, key := range append([]string{c.APIKey}, c.APIKeys...) {
+		if key != "" && !seen[key] {
+			seen[key] = true
+			keys = append(keys, key)
+		}
+	}
+	return keys
+}
+
 func (c *ServerConfig) GetServerAddress() string {
 	return fmt.Sprintf("%s:%s", c.Host, c.Port)
 }
@@ -161,6 +336,28 @@ func getEnvInt(key string, defaultValue int) int {
 	return defaultValue
 }
 
+func getEnvBool(key string, defaultValue bool) bool {
+	if value := os.Getenv(key); value != "" {
+		if boolVal, err := strconv.ParseBool(value); err == nil {
+			return boolVal
+		}
+	}
+	return defaultValue
+}
+
+// getEnvList reads a comma separated list, ignoring blank entries
+func getEnvList(key, defaultValue string) []string {
+	value := getEnv(key, defaultValue)
+
+	items := []string{}
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
 func getEnvDuration(key, defaultValue string) time.Duration {
 	if value := os.Getenv(key); value != "" {
 		if duration, err := time.ParseDuration(value); err == nil {
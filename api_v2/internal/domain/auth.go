@@ -1,6 +1,9 @@
 package domain
 
 import (
+	"context"
+	"time"
+
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/google/uuid"
 )
@@ -29,6 +32,99 @@ type JWTClaims struct {
 	jwt.RegisteredClaims
 }
 
+// RefreshToken is an opaque, single-use token that can be exchanged for a new access token.
+// Only the hash is stored; a used token is kept to detect replays.
+type RefreshToken struct {
+	ID        uuid.UUID  `db:"id"`
+	SessionID uuid.UUID  `db:"session_id"`
+	UserID    uuid.UUID  `db:"user_id"`
+	TokenHash string     `db:"token_hash"`
+	ExpiresAt time.Time  `db:"expires_at"`
+	UsedAt    *time.Time `db:"used_at"`
+	CreatedAt time.Time  `db:"created_at"`
+}
+
+type RefreshTokenRepository interface {
+	CreateRefreshToken(ctx context.Context, token *RefreshToken) error
+	GetRefreshTokenByHash(ctx context.Context, tokenHash string) (*RefreshToken, error)
+	// MarkRefreshTokenUsed returns false when the token had already been used
+	MarkRefreshTokenUsed(ctx context.Context, id uuid.UUID) (bool, error)
+}
+
+// PasswordResetToken is a single-use token sent by email to reset a forgotten password.
+// The token column holds the SHA-256 hash of the value sent to the user.
+type PasswordResetToken struct {
+	ID        uuid.UUID  `db:"id"`
+	UserID    uuid.UUID  `db:"user_id"`
+	TokenHash string     `db:"token"`
+	ExpiresAt time.Time  `db:"expires_at"`
+	UsedAt    *time.Time `db:"used_at"`
+	CreatedAt time.Time  `db:"created_at"`
+}
+
+type PasswordResetTokenRepository interface {
+	CreatePasswordResetToken(ctx context.Context, token *PasswordResetToken) error
+	GetPasswordResetTokenByHash(ctx context.Context, tokenHash string) (*PasswordResetToken, error)
+	// GetUserPasswordResetTokensSince returns the tokens issued to a user after the given time, newest first
+	GetUserPasswordResetTokensSince(ctx context.Context, userID uuid.UUID, since time.Time) ([]PasswordResetToken, error)
+	// MarkPasswordResetTokenUsed returns false when the token had already been used
+	MarkPasswordResetTokenUsed(ctx context.Context, id uuid.UUID) (bool, error)
+	DeleteUserPasswordResetTokens(ctx context.Context, userID uuid.UUID) error
+}
+
+// EmailVerificationToken confirms ownership of the email address of an account.
+// The token column holds the SHA-256 hash of the value sent to the user.
+type EmailVerificationToken struct {
+	ID        uuid.UUID `db:"id"`
+	UserID    uuid.UUID `db:"user_id"`
+	TokenHash string    `db:"token"`
+	ExpiresAt time.Time `db:"expires_at"`
+	CreatedAt time.Time `db:"created_at"`
+}
+
+type EmailVerificationTokenRepository interface {
+	CreateEmailVerificationToken(ctx context.Context, token *EmailVerificationToken) error
+	GetEmailVerificationTokenByHash(ctx context.Context, tokenHash string) (*EmailVerificationToken, error)
+	// GetUserEmailVerificationTokensSince returns the tokens issued to a user after the given time, newest first
+	GetUserEmailVerificationTokensSince(ctx context.Context, userID uuid.UUID, since time.Time) ([]EmailVerificationToken, error)
+	DeleteUserEmailVerificationTokens(ctx context.Context, userID uuid.UUID) error
+}
+
+// EmailChangeToken confirms a new email address before it replaces the current one.
+// Only the hash of the token sent to the new address is stored.
+type EmailChangeToken struct {
+	ID                  uuid.UUID  `db:"id"`
+	UserID              uuid.UUID  `db:"user_id"`
+	SessionID           *uuid.UUID `db:"session_id"`
+	NewEmail            string     `db:"new_email"`
+	TokenHash           string     `db:"token_hash"`
+	RevokeOtherSessions bool       `db:"revoke_other_sessions"`
+	ExpiresAt           time.Time  `db:"expires_at"`
+	CreatedAt           time.Time  `db:"created_at"`
+}
+
+type EmailChangeTokenRepository interface {
+	CreateEmailChangeToken(ctx context.Context, token *EmailChangeToken) error
+	GetEmailChangeTokenByHash(ctx context.Context, tokenHash string) (*EmailChangeToken, error)
+	DeleteUserEmailChangeTokens(ctx context.Context, userID uuid.UUID) error
+}
+
+// LoginLockout records an email or IP address locked after repeated failed logins
+type LoginLockout struct {
+	ID          uuid.UUID `db:"id"`
+	Scope       string    `db:"scope"`
+	Identifier  string    `db:"identifier"`
+	Failures    int       `db:"failures"`
+	IPAddress   *string   `db:"ip_address"`
+	UserAgent   *string   `db:"user_agent"`
+	LockedUntil time.Time `db:"locked_until"`
+	CreatedAt   time.Time `db:"created_at"`
+}
+
+type LoginLockoutRepository interface {
+	CreateLoginLockout(ctx context.Context, lockout *LoginLockout) error
+}
+
 type AuthService interface {
 	Register(req RegisterRequest) (*AuthResponse, error)
 	Login(req LoginRequest) (*AuthResponse, error)
@@ -38,9 +134,8 @@ type AuthService interface {
 }
 
 type JWTService interface {
-	GenerateToken(userID uuid.UUID) (string, error)
+	GenerateToken(user *User) (string, error)
 	ValidateToken(token string) (*JWTClaims, error)
-	ParseToken(token string) (*JWTClaims, error)
 }
 
 type PasswordService interface {
@@ -0,0 +1,69 @@
+package domain
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// UserTOTP is the TOTP enrollment of a user.
+// Two-factor authentication is only enforced once EnabledAt is set.
+type UserTOTP struct {
+	UserID       uuid.UUID  `db:"user_id"`
+	Secret       string     `db:"secret"`
+	EnabledAt    *time.Time `db:"enabled_at"`
+	LastUsedStep *int64     `db:"last_used_step"`
+	CreatedAt    time.Time  `db:"created_at"`
+}
+
+func (t *UserTOTP) Enabled() bool {
+	return t.EnabledAt != nil
+}
+
+type TOTPRepository interface {
+	GetUserTOTP(ctx context.Context, userID uuid.UUID) (*UserTOTP, error)
+	// SaveUserTOTP stores a new, not yet enabled enrollment, replacing any previous pending one
+	SaveUserTOTP(ctx context.Context, totp *UserTOTP) error
+	EnableUserTOTP(ctx context.Context, userID uuid.UUID) error
+	// UseTOTPStep records the time step of an accepted code; returns false if it was already used
+	UseTOTPStep(ctx context.Context, userID uuid.UUID, step int64) (bool, error)
+	DeleteUserTOTP(ctx context.Context, userID uuid.UUID) error
+}
+
+type RecoveryCode struct {
+	ID        uuid.UUID  `db:"id"`
+	UserID    uuid.UUID  `db:"user_id"`
+	CodeHash  string     `db:"code_hash"`
+	UsedAt    *time.Time `db:"used_at"`
+	CreatedAt time.Time  `db:"created_at"`
+}
+
+type RecoveryCodeRepository interface {
+	// ReplaceUserRecoveryCodes discards the current codes of a user and stores the given hashes
+	ReplaceUserRecoveryCodes(ctx context.Context, userID uuid.UUID, codeHashes []string) error
+	// UseRecoveryCode marks an unused code as used; returns false if no such code exists
+	UseRecoveryCode(ctx context.Context, userID uuid.UUID, codeHash string) (bool, error)
+	CountUnusedRecoveryCodes(ctx context.Context, userID uuid.UUID) (int, error)
+	DeleteUserRecoveryCodes(ctx context.Context, userID uuid.UUID) error
+}
+
+// MFAChallenge is issued by the first login step when 2FA is enabled.
+// Only the hash of the challenge token is stored.
+type MFAChallenge struct {
+	ID        uuid.UUID `db:"id"`
+	UserID    uuid.UUID `db:"user_id"`
+	TokenHash string    `db:"token_hash"`
+	Attempts  int       `db:"attempts"`
+	ExpiresAt time.Time `db:"expires_at"`
+	CreatedAt time.Time `db:"created_at"`
+}
+
+type MFAChallengeRepository interface {
+	CreateMFAChallenge(ctx context.Context, challenge *MFAChallenge) error
+	GetMFAChallengeByHash(ctx context.Context, tokenHash string) (*MFAChallenge, error)
+	// UseMFAChallengeAttempt counts an attempt unless maxAttempts were already made, in which case it returns false.
+	// The check and the increment are atomic so concurrent attempts can't go over the limit.
+	UseMFAChallengeAttempt(ctx context.Context, id uuid.UUID, maxAttempts int) (bool, error)
+	DeleteMFAChallenge(ctx context.Context, id uuid.UUID) error
+}
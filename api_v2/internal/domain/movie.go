@@ -1,6 +1,7 @@
 package domain
 
 import (
+	"context"
 	"time"
 
 	"github.com/google/uuid"
@@ -8,23 +9,55 @@ import (
 )
 
 type Movie struct {
-	ID             uuid.UUID      `db:"id" json:"id"`
-	ExternalAPIID  string         `db:"external_api_id" json:"external_api_id"`
-	Provider       string         `db:"provider" json:"provider"` // "omdb", "tmdb", "internal"
-	Title          string         `db:"title" json:"title"`
-	Overview       *string        `db:"overview" json:"overview,omitempty"`
-	ReleaseDate    *time.Time     `db:"release_date" json:"release_date,omitempty"`
-	PosterURL      *string        `db:"poster_url" json:"poster_url,omitempty"`
-	BackdropURL    *string        `db:"backdrop_url" json:"backdrop_url,omitempty"`
-	Genres         pq.StringArray `db:"genres" json:"genres"`
-	Runtime        *int           `db:"runtime" json:"runtime,omitempty"` // minutes
-	VoteAverage    *float64       `db:"vote_average" json:"vote_average,omitempty"`
-	VoteCount      *int           `db:"vote_count" json:"vote_count,omitempty"`
-	Adult          bool           `db:"adult" json:"adult"`
-	LastSyncAt     *time.Time     `db:"last_sync_at" json:"last_sync_at,omitempty"`
-	CacheExpiresAt time.Time      `db:"cache_expires_at" json:"-"`
-	CreatedAt      time.Time      `db:"created_at" json:"created_at"`
-	UpdatedAt      time.Time      `db:"updated_at" json:"updated_at"`
+	ID               uuid.UUID      `db:"id" json:"id"`
+	ExternalAPIID    string         `db:"external_api_id" json:"external_api_id"`
+	Provider         string         `db:"provider" json:"provider"`                     // "omdb", "tmdb", "internal"
+	TitleType        string         `db:"title_type" json:"title_type"`                 // "movie", "series", "episode"
+	TotalSeasons     *int           `db:"total_seasons" json:"total_seasons,omitempty"` // series only
+	TMDbID           *int           `db:"tmdb_id" json:"tmdb_id,omitempty"`             // set for movies fetched from TMDb
+	Title            string         `db:"title" json:"title"`
+	Overview         *string        `db:"overview" json:"overview,omitempty"`
+	ReleaseDate      *time.Time     `db:"release_date" json:"release_date,omitempty"`
+	PosterURL        *string        `db:"poster_url" json:"poster_url,omitempty"`
+	BackdropURL      *string        `db:"backdrop_url" json:"backdrop_url,omitempty"`
+	Genres           pq.StringArray `db:"genres" json:"genres"`
+	Runtime          *int           `db:"runtime" json:"runtime,omitempty"` // minutes
+	VoteAverage      *float64       `db:"vote_average" json:"vote_average,omitempty"`
+	VoteCount        *int           `db:"vote_count" json:"vote_count,omitempty"`
+	Adult            bool           `db:"adult" json:"adult"`
+	Languages        pq.StringArray `db:"languages" json:"languages,omitempty"`
+	Countries        pq.StringArray `db:"countries" json:"countries,omitempty"`
+	Awards           *string        `db:"awards" json:"awards,omitempty"`
+	AwardWins        *int           `db:"award_wins" json:"award_wins,omitempty"`
+	AwardNominations *int           `db:"award_nominations" json:"award_nominations,omitempty"`
+	BoxOffice        *int64         `db:"box_office" json:"box_office,omitempty"` // US dollars
+	LastSyncAt       *time.Time     `db:"last_sync_at" json:"last_sync_at,omitempty"`
+	CacheExpiresAt   time.Time      `db:"cache_expires_at" json:"-"`
+	CreatedAt        time.Time      `db:"created_at" json:"created_at"`
+	UpdatedAt        time.Time      `db:"updated_at" json:"updated_at"`
+
+	// Credits and Ratings are stored in their own tables. They are only loaded by
+	// GetMovieCredits and GetMovieRatings; nil leaves the stored ones untouched on save.
+	Credits []MovieCredit `db:"-" json:"credits,omitempty"`
+	Ratings []MovieRating `db:"-" json:"ratings,omitempty"`
+}
+
+const (
+	RatingSourceIMDb           = "imdb"
+	RatingSourceRottenTomatoes = "rotten_tomatoes"
+	RatingSourceMetacritic     = "metacritic"
+	RatingSourceTMDb           = "tmdb"
+)
+
+// MovieRating is the rating of a movie on an external source. Value is the rating as the
+// source shows it ("8.8/10", "87%"); Score is the same rating on a 0-100 scale.
+type MovieRating struct {
+	MovieID   uuid.UUID `db:"movie_id" json:"-"`
+	Source    string    `db:"source" json:"source"`
+	Value     string    `db:"value" json:"value"`
+	Score     *float64  `db:"score" json:"score,omitempty"`
+	Votes     *int      `db:"votes" json:"votes,omitempty"`
+	UpdatedAt time.Time `db:"updated_at" json:"updated_at"`
 }
 
 type Genre struct {
@@ -32,15 +65,38 @@ type Genre struct {
 	Name string `json:"name"`
 }
 
+// MovieBrowseFilter narrows down the stored movies listed by the browse route.
+// Empty fields don't filter; a movie must credit every person of PersonIDs, in Role when set.
+type MovieBrowseFilter struct {
+	Genre     string
+	TitleType string
+	PersonIDs []uuid.UUID
+	Role      string
+	Limit     int
+	Offset    int
+}
+
 type MovieRepository interface {
-	CreateMovie(movie *Movie) error
-	GetMovieByID(id uuid.UUID) (*Movie, error)
-	GetMovieByExternalID(externalID string) (*Movie, error)
-	UpdateMovie(movie *Movie) error
-	DeleteMovie(id uuid.UUID) error
-	GetRandomMovie() (*Movie, error)
-	GetRandomMovieByGenre(genre string) (*Movie, error)
-	SearchMovies(query string, limit int) ([]*Movie, error)
-	GetRandomMovies(limit int) ([]*Movie, error)
-	CountMovies() (int, error)
+	CreateMovie(ctx context.Context, movie *Movie) error
+	GetMovieByID(ctx context.Context, id uuid.UUID) (*Movie, error)
+	GetMovieByExternalID(ctx context.Context, externalID string) (*Movie, error)
+	// GetMovieByTMDbID finds a movie fetched from TMDb, whatever external ID it is stored under
+	GetMovieByTMDbID(ctx context.Context, tmdbID int) (*Movie, error)
+	// GetMoviesByIDs returns the movies that exist among ids, in no particular order
+	GetMoviesByIDs(ctx context.Context, ids []uuid.UUID) ([]*Movie, error)
+	UpdateMovie(ctx context.Context, movie *Movie) error
+	DeleteMovie(ctx context.Context, id uuid.UUID) error
+	GetRandomMovie(ctx context.Context) (*Movie, error)
+	GetRandomMovieByGenre(ctx context.Context, genre string) (*Movie, error)
+	SearchMovies(ctx context.Context, query string, limit int) ([]*Movie, error)
+	GetRandomMovies(ctx context.Context, limit int) ([]*Movie, error)
+	CountMovies(ctx context.Context) (int, error)
+	// GetExpiredMovies returns provider movies whose cache_expires_at has passed, most watched and
+	// favorited first, leaving out the given IDs
+	GetExpiredMovies(ctx context.Context, limit int, excludeIDs []uuid.UUID) ([]*Movie, error)
+	// GetMovieCredits returns the directors, writers and cast of a movie in billing order
+	GetMovieCredits(ctx context.Context, movieID uuid.UUID) ([]MovieCredit, error)
+	GetMovieRatings(ctx context.Context, movieID uuid.UUID) ([]MovieRating, error)
+	// BrowseMovies lists stored movies matching filter, newest first, with the total number of matches
+	BrowseMovies(ctx context.Context, filter MovieBrowseFilter) ([]*Movie, int, error)
 }
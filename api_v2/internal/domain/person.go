@@ -0,0 +1,64 @@
+package domain
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	CreditRoleDirector = "director"
+	CreditRoleWriter   = "writer"
+	CreditRoleActor    = "actor"
+)
+
+func IsValidCreditRole(role string) bool {
+	return role == CreditRoleDirector || role == CreditRoleWriter || role == CreditRoleActor
+}
+
+// Person is someone credited on movies. Providers give no stable ID for people,
+// so a person is identified by name.
+type Person struct {
+	ID        uuid.UUID `db:"id" json:"id"`
+	Name      string    `db:"name" json:"name"`
+	CreatedAt time.Time `db:"created_at" json:"created_at"`
+	UpdatedAt time.Time `db:"updated_at" json:"updated_at"`
+}
+
+// MovieCredit links a person to a movie with a role. BillingOrder is the position of the
+// person among the movie's credits with the same role, starting at 0.
+type MovieCredit struct {
+	MovieID       uuid.UUID `db:"movie_id" json:"-"`
+	PersonID      uuid.UUID `db:"person_id" json:"person_id"`
+	Name          string    `db:"name" json:"name"`
+	Role          string    `db:"role" json:"role"`
+	CharacterName *string   `db:"character_name" json:"character_name,omitempty"`
+	BillingOrder  int       `db:"billing_order" json:"billing_order"`
+}
+
+// PersonCredit is one entry of a person's filmography: a stored movie and the person's part in it
+type PersonCredit struct {
+	MovieID       uuid.UUID  `db:"movie_id"`
+	ExternalAPIID string     `db:"external_api_id"`
+	Title         string     `db:"title"`
+	ReleaseDate   *time.Time `db:"release_date"`
+	PosterURL     *string    `db:"poster_url"`
+	Role          string     `db:"role"`
+	CharacterName *string    `db:"character_name"`
+	BillingOrder  int        `db:"billing_order"`
+}
+
+// PersonSearchResult is a person found by name with the number of stored movies crediting them
+type PersonSearchResult struct {
+	Person
+	MovieCount int `db:"movie_count"`
+}
+
+type PersonRepository interface {
+	GetPersonByID(ctx context.Context, id uuid.UUID) (*Person, error)
+	// SearchPeople matches names containing query or similar to it, best matches first
+	SearchPeople(ctx context.Context, query string, limit, offset int) ([]PersonSearchResult, int, error)
+	// GetPersonFilmography returns the person's credits on stored movies, newest movies first
+	GetPersonFilmography(ctx context.Context, personID uuid.UUID) ([]PersonCredit, error)
+}
@@ -0,0 +1,78 @@
+package domain
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+// PersonalAccessTokenPrefix starts every personal access token so the auth middleware
+// can tell them apart from JWT access tokens
+const PersonalAccessTokenPrefix = "cine_pat_"
+
+// Scopes a personal access token can be granted
+const (
+	ScopeProfileRead    = "profile:read"
+	ScopeProfileWrite   = "profile:write"
+	ScopeWatchedRead    = "watched:read"
+	ScopeWatchedWrite   = "watched:write"
+	ScopeFavoritesRead  = "favorites:read"
+	ScopeFavoritesWrite = "favorites:write"
+)
+
+var PersonalAccessTokenScopes = []string{
+	ScopeProfileRead,
+	ScopeProfileWrite,
+	ScopeWatchedRead,
+	ScopeWatchedWrite,
+	ScopeFavoritesRead,
+	ScopeFavoritesWrite,
+}
+
+func IsValidScope(scope string) bool {
+	for _, s := range PersonalAccessTokenScopes {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
+
+// PersonalAccessToken is a long-lived credential limited to a set of scopes.
+// Only the hash of the token is stored.
+type PersonalAccessToken struct {
+	ID          uuid.UUID      `db:"id"`
+	UserID      uuid.UUID      `db:"user_id"`
+	Name        string         `db:"name"`
+	TokenHash   string         `db:"token_hash"`
+	TokenPrefix string         `db:"token_prefix"`
+	Scopes      pq.StringArray `db:"scopes"`
+	ExpiresAt   *time.Time     `db:"expires_at"`
+	LastUsedAt  *time.Time     `db:"last_used_at"`
+	CreatedAt   time.Time      `db:"created_at"`
+}
+
+func (t *PersonalAccessToken) Expired() bool {
+	return t.ExpiresAt != nil && !t.ExpiresAt.After(time.Now())
+}
+
+func (t *PersonalAccessToken) HasScope(scope string) bool {
+	for _, s := range t.Scopes {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
+
+type PersonalAccessTokenRepository interface {
+	CreatePersonalAccessToken(ctx context.Context, token *PersonalAccessToken) error
+	GetPersonalAccessTokenByHash(ctx context.Context, tokenHash string) (*PersonalAccessToken, error)
+	GetUserPersonalAccessTokens(ctx context.Context, userID uuid.UUID) ([]PersonalAccessToken, error)
+	TouchPersonalAccessToken(ctx context.Context, id uuid.UUID) error
+	// DeletePersonalAccessToken removes a token of the given user; returns false if there was none
+	DeletePersonalAccessToken(ctx context.Context, id, userID uuid.UUID) (bool, error)
+	DeleteUserPersonalAccessTokens(ctx context.Context, userID uuid.UUID) error
+}
@@ -0,0 +1,48 @@
+package domain
+
+const (
+	RoleUser      = "user"
+	RoleModerator = "moderator"
+	RoleAdmin     = "admin"
+)
+
+// Permissions checked by the admin routes
+const (
+	PermissionUsersRead        = "users:read"
+	PermissionUsersModerate    = "users:moderate"
+	PermissionUsersManageRoles = "users:manage_roles"
+	PermissionMoviesWrite      = "movies:write"
+	PermissionMoviesDelete     = "movies:delete"
+	PermissionProvidersRead    = "providers:read"
+)
+
+var rolePermissions = map[string][]string{
+	RoleUser: {},
+	RoleModerator: {
+		PermissionUsersRead,
+		PermissionUsersModerate,
+		PermissionMoviesWrite,
+	},
+	RoleAdmin: {
+		PermissionUsersRead,
+		PermissionUsersModerate,
+		PermissionUsersManageRoles,
+		PermissionMoviesWrite,
+		PermissionMoviesDelete,
+		PermissionProvidersRead,
+	},
+}
+
+func IsValidRole(role string) bool {
+	_, ok := rolePermissions[role]
+	return ok
+}
+
+func RoleHasPermission(role, permission string) bool {
+	for _, p := range rolePermissions[role] {
+		if p == permission {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,49 @@
+package domain
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	TitleTypeMovie   = "movie"
+	TitleTypeSeries  = "series"
+	TitleTypeEpisode = "episode"
+)
+
+// Season is a season of a series, a title stored with the movies. Its episodes are synced
+// together and expire with the season.
+type Season struct {
+	SeriesID       uuid.UUID  `db:"series_id"`
+	SeasonNumber   int        `db:"season_number"`
+	EpisodeCount   int        `db:"episode_count"`
+	LastSyncAt     *time.Time `db:"last_sync_at"`
+	CacheExpiresAt time.Time  `db:"cache_expires_at"`
+}
+
+type Episode struct {
+	ID            uuid.UUID  `db:"id"`
+	SeriesID      uuid.UUID  `db:"series_id"`
+	SeasonNumber  int        `db:"season_number"`
+	EpisodeNumber int        `db:"episode_number"`
+	ExternalAPIID *string    `db:"external_api_id"` // IMDb ID
+	Title         string     `db:"title"`
+	ReleaseDate   *time.Time `db:"release_date"`
+	IMDbRating    *float64   `db:"imdb_rating"`
+	CreatedAt     time.Time  `db:"created_at"`
+	UpdatedAt     time.Time  `db:"updated_at"`
+}
+
+type SeriesRepository interface {
+	GetSeasons(ctx context.Context, seriesID uuid.UUID) ([]Season, error)
+	GetSeason(ctx context.Context, seriesID uuid.UUID, seasonNumber int) (*Season, error)
+	// SaveSeason creates or updates the season and its episodes, filling in the episode IDs.
+	// Episodes keep their ID when synced again.
+	SaveSeason(ctx context.Context, season *Season, episodes []Episode) error
+	GetSeasonEpisodes(ctx context.Context, seriesID uuid.UUID, seasonNumber int) ([]Episode, error)
+	// GetSeriesEpisodes returns the stored episodes of every season, in order
+	GetSeriesEpisodes(ctx context.Context, seriesID uuid.UUID) ([]Episode, error)
+	GetEpisodeByID(ctx context.Context, id uuid.UUID) (*Episode, error)
+}
@@ -1,50 +1,108 @@
 package domain
 
 import (
+	"context"
 	"time"
 
 	"github.com/google/uuid"
 )
 
 type User struct {
-	ID                uuid.UUID `db:"id" json:"id"`
-	Username          string    `db:"username" json:"username"`
-	Email             string    `db:"email" json:"email"`
-	DisplayName       string    `db:"display_name" json:"display_name"`
-	Bio               *string   `db:"bio" json:"bio,omitempty"`
-	ProfilePictureURL *string   `db:"profile_picture_url" json:"profile_picture_url,omitempty"`
-	PasswordHash      string    `db:"password_hash" json:"-"`
-	IsPrivate         bool      `db:"is_private" json:"is_private"`
-	EmailVerified     bool      `db:"email_verified" json:"email_verified"`
-	Theme             string    `db:"theme" json:"theme"`
-	CreatedAt         time.Time `db:"created_at" json:"created_at"`
-	UpdatedAt         time.Time `db:"updated_at" json:"updated_at"`
+	ID                  uuid.UUID  `db:"id" json:"id"`
+	Username            string     `db:"username" json:"username"`
+	Email               string     `db:"email" json:"email"`
+	DisplayName         string     `db:"display_name" json:"display_name"`
+	Bio                 *string    `db:"bio" json:"bio,omitempty"`
+	ProfilePictureURL   *string    `db:"profile_picture_url" json:"profile_picture_url,omitempty"`
+	PasswordHash        string     `db:"password_hash" json:"-"`
+	IsPrivate           bool       `db:"is_private" json:"is_private"`
+	EmailVerified       bool       `db:"email_verified" json:"email_verified"`
+	Theme               string     `db:"theme" json:"theme"`
+	Role                string     `db:"role" json:"role"`
+	DisabledAt          *time.Time `db:"disabled_at" json:"disabled_at,omitempty"`
+	UsernameChangedAt   *time.Time `db:"username_changed_at" json:"username_changed_at,omitempty"`
+	DeletionScheduledAt *time.Time `db:"deletion_scheduled_at" json:"deletion_scheduled_at,omitempty"`
+	CreatedAt           time.Time  `db:"created_at" json:"created_at"`
+	UpdatedAt           time.Time  `db:"updated_at" json:"updated_at"`
+}
+
+func (u *User) Disabled() bool {
+	return u.DisabledAt != nil
+}
+
+func (u *User) HasPermission(permission string) bool {
+	return RoleHasPermission(u.Role, permission)
+}
+
+// UserProfileStats are the counters shown on a profile
+type UserProfileStats struct {
+	ReviewCount    int `db:"review_count"`
+	MovieListCount int `db:"movie_list_count"`
+	FollowerCount  int `db:"follower_count"`
+	FollowingCount int `db:"following_count"`
+}
+
+// UserListFilter narrows down the users listed by the admin routes.
+// Empty fields don't filter.
+type UserListFilter struct {
+	Query    string
+	Role     string
+	Disabled *bool
+	Limit    int
+	Offset   int
 }
 
 type UserSession struct {
-	ID        uuid.UUID `db:"id" json:"-"`
-	UserID    uuid.UUID `db:"user_id" json:"user_id"`
-	Token     string    `db:"token" json:"token"`
-	ExpiresAt time.Time `db:"expires_at" json:"expires_at"`
-	CreatedAt time.Time `db:"created_at" json:"created_at"`
-	UserAgent *string   `db:"user_agent" json:"user_agent,omitempty"`
-	IPAddress *string   `db:"ip_address" json:"ip_address,omitempty"`
+	ID         uuid.UUID  `db:"id" json:"-"`
+	UserID     uuid.UUID  `db:"user_id" json:"user_id"`
+	Token      string     `db:"token" json:"token"`
+	ExpiresAt  time.Time  `db:"expires_at" json:"expires_at"`
+	CreatedAt  time.Time  `db:"created_at" json:"created_at"`
+	LastUsedAt *time.Time `db:"last_used_at" json:"last_used_at,omitempty"`
+	UserAgent  *string    `db:"user_agent" json:"user_agent,omitempty"`
+	IPAddress  *string    `db:"ip_address" json:"ip_address,omitempty"`
 }
 
 type UserRepository interface {
-	CreateUser(user *User) error
-	GetUserByID(id uuid.UUID) (*User, error)
-	GetUserByEmail(email string) (*User, error)
-	GetUserByUsername(username string) (*User, error)
-	UpdateUser(user *User) error
-	DeleteUser(id uuid.UUID) error
+	CreateUser(ctx context.Context, user *User) error
+	GetUserByID(ctx context.Context, id uuid.UUID) (*User, error)
+	GetUserByEmail(ctx context.Context, email string) (*User, error)
+	GetUserByUsername(ctx context.Context, username string) (*User, error)
+	UpdateUser(ctx context.Context, user *User) error
+	UpdatePassword(ctx context.Context, id uuid.UUID, passwordHash string) error
+	ScheduleUserDeletion(ctx context.Context, id uuid.UUID, at time.Time) error
+	CancelUserDeletion(ctx context.Context, id uuid.UUID) error
+	// PurgeScheduledUsers hard deletes the users whose deletion date has passed and returns how many were removed
+	PurgeScheduledUsers(ctx context.Context, now time.Time) (int64, error)
+	DeleteUser(ctx context.Context, id uuid.UUID) error
+	// ListUsers returns a page of users matching the filter and the total number of matches
+	ListUsers(ctx context.Context, filter UserListFilter) ([]User, int, error)
+	CountUsersByRole(ctx context.Context, role string) (int, error)
+	UpdateUserRole(ctx context.Context, id uuid.UUID, role string) error
+	// SetUserDisabled disables the user at the given time, or enables it again when at is nil
+	SetUserDisabled(ctx context.Context, id uuid.UUID, at *time.Time) error
+	// SearchUsers matches the query against usernames and display names (prefix or trigram similarity).
+	// Private, disabled and soon deleted accounts are only found by their exact username.
+	SearchUsers(ctx context.Context, query string, limit, offset int) ([]User, int, error)
+	// IsUsernameReserved reports whether a username given up by another user is still reserved for them
+	IsUsernameReserved(ctx context.Context, username string, userID uuid.UUID) (bool, error)
+	// ChangeUsername renames a user and reserves the previous username for them until reservedUntil
+	ChangeUsername(ctx context.Context, id uuid.UUID, oldUsername, newUsername string, reservedUntil time.Time) error
+	GetUserProfileStats(ctx context.Context, id uuid.UUID) (*UserProfileStats, error)
+	IsFollowing(ctx context.Context, followerID, followingID uuid.UUID) (bool, error)
 }
 
 type SessionRepository interface {
-	CreateSession(session *UserSession) error
-	GetSessionByToken(token string) (*UserSession, error)
-	DeleteSession(token string) error
-	DeleteUserSessions(userID uuid.UUID) error
+	CreateSession(ctx context.Context, session *UserSession) error
+	GetSessionByToken(ctx context.Context, token string) (*UserSession, error)
+	GetSessionByID(ctx context.Context, id uuid.UUID) (*UserSession, error)
+	GetUserSessions(ctx context.Context, userID uuid.UUID) ([]UserSession, error)
+	UpdateSession(ctx context.Context, session *UserSession) error
+	TouchSession(ctx context.Context, id uuid.UUID) error
+	DeleteSession(ctx context.Context, token string) error
+	DeleteSessionByID(ctx context.Context, id uuid.UUID) error
+	DeleteUserSessions(ctx context.Context, userID uuid.UUID) error
+	DeleteUserSessionsExcept(ctx context.Context, userID, keepSessionID uuid.UUID) error
 }
 
 type UserService interface {
@@ -53,3 +111,9 @@ type UserService interface {
 	UpdateSettings(userID uuid.UUID, theme *string, isPrivate *bool) error
 	CheckUsernameAvailability(username string, excludeUserID *uuid.UUID) (bool, error)
 }
+
+// UserDataExportRepository collects every row owned by a user, grouped by table name.
+// Secrets such as password and token hashes are left out.
+type UserDataExportRepository interface {
+	ExportUserData(ctx context.Context, userID uuid.UUID) (map[string][]map[string]interface{}, error)
+}
@@ -1,6 +1,7 @@
 package domain
 
 import (
+	"context"
 	"time"
 
 	"github.com/google/uuid"
@@ -24,18 +25,36 @@ type FavoriteMovie struct {
 	CreatedAt   time.Time `db:"created_at"`
 }
 
+// WatchedEpisode represents an episode of a series that a user has watched
+type WatchedEpisode struct {
+	ID        uuid.UUID `db:"id"`
+	UserID    uuid.UUID `db:"user_id"`
+	EpisodeID uuid.UUID `db:"episode_id"`
+	WatchedAt time.Time `db:"watched_at"`
+	CreatedAt time.Time `db:"created_at"`
+}
+
 // WatchedMovieRepository interface for watched movies operations
 type WatchedMovieRepository interface {
-	AddWatchedMovie(userID, movieID uuid.UUID) (*WatchedMovie, error)
-	RemoveWatchedMovie(userID, movieID uuid.UUID) error
-	IsMovieWatched(userID, movieID uuid.UUID) (bool, error)
-	GetUserWatchedMovies(userID uuid.UUID) ([]WatchedMovie, error)
+	AddWatchedMovie(ctx context.Context, userID, movieID uuid.UUID) (*WatchedMovie, error)
+	RemoveWatchedMovie(ctx context.Context, userID, movieID uuid.UUID) error
+	IsMovieWatched(ctx context.Context, userID, movieID uuid.UUID) (bool, error)
+	GetUserWatchedMovies(ctx context.Context, userID uuid.UUID) ([]WatchedMovie, error)
 }
 
 // FavoriteMovieRepository interface for favorite movies operations
 type FavoriteMovieRepository interface {
-	AddFavoriteMovie(userID, movieID uuid.UUID) (*FavoriteMovie, error)
-	RemoveFavoriteMovie(userID, movieID uuid.UUID) error
-	IsMovieFavorite(userID, movieID uuid.UUID) (bool, error)
-	GetUserFavoriteMovies(userID uuid.UUID) ([]FavoriteMovie, error)
+	AddFavoriteMovie(ctx context.Context, userID, movieID uuid.UUID) (*FavoriteMovie, error)
+	RemoveFavoriteMovie(ctx context.Context, userID, movieID uuid.UUID) error
+	IsMovieFavorite(ctx context.Context, userID, movieID uuid.UUID) (bool, error)
+	GetUserFavoriteMovies(ctx context.Context, userID uuid.UUID) ([]FavoriteMovie, error)
+}
+
+// WatchedEpisodeRepository interface for watched episodes operations
+type WatchedEpisodeRepository interface {
+	AddWatchedEpisode(ctx context.Context, userID, episodeID uuid.UUID) (*WatchedEpisode, error)
+	RemoveWatchedEpisode(ctx context.Context, userID, episodeID uuid.UUID) error
+	IsEpisodeWatched(ctx context.Context, userID, episodeID uuid.UUID) (bool, error)
+	// GetWatchedEpisodesOfSeries returns the episodes of a series the user has watched
+	GetWatchedEpisodesOfSeries(ctx context.Context, userID, seriesID uuid.UUID) ([]WatchedEpisode, error)
 }
@@ -0,0 +1,55 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	UsernameMinLength = 3
+	UsernameMaxLength = 30
+)
+
+// reservedUsernames can't be registered because they clash with routes or impersonate staff
+var reservedUsernames = map[string]bool{
+	"admin":          true,
+	"administrator":  true,
+	"api":            true,
+	"auth":           true,
+	"check-username": true,
+	"cineverse":      true,
+	"help":           true,
+	"me":             true,
+	"moderator":      true,
+	"root":           true,
+	"search":         true,
+	"settings":       true,
+	"support":        true,
+	"system":         true,
+}
+
+// NormalizeUsername returns the canonical form usernames are stored and compared in
+func NormalizeUsername(username string) string {
+	return strings.ToLower(strings.TrimSpace(username))
+}
+
+// ValidateUsername checks a normalized username: 3-30 lowercase letters, digits, '_' or '.',
+// starting with a letter or digit, and not a reserved name
+func ValidateUsername(username string) error {
+	if len(username) < UsernameMinLength || len(username) > UsernameMaxLength {
+		return fmt.Errorf("invalid username")
+	}
+
+	for i, c := range username {
+		isAlphanumeric := (c >= 'a' && c <= 'z') || (c >= '0' && c <= '9')
+		if !isAlphanumeric && (i == 0 || (c != '_' && c != '.')) {
+			return fmt.Errorf("invalid username")
+		}
+	}
+
+	if reservedUsernames[username] {
+		return fmt.Errorf("username reserved")
+	}
+
+	return nil
+}
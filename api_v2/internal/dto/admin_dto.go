@@ -0,0 +1,126 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// AdminUserDTO is the view of an account shown to moderators and admins
+type AdminUserDTO struct {
+	ID                  uuid.UUID  `json:"id"`
+	Username            string     `json:"username"`
+	Email               string     `json:"email"`
+	DisplayName         string     `json:"display_name"`
+	Role                string     `json:"role"`
+	EmailVerified       bool       `json:"email_verified"`
+	IsPrivate           bool       `json:"is_private"`
+	DisabledAt          *time.Time `json:"disabled_at,omitempty"`
+	DeletionScheduledAt *time.Time `json:"deletion_scheduled_at,omitempty"`
+	CreatedAt           time.Time  `json:"created_at"`
+	UpdatedAt           time.Time  `json:"updated_at"`
+}
+
+type AdminUserListDTO struct {
+	Users    []AdminUserDTO `json:"users"`
+	Total    int            `json:"total"`
+	Page     int            `json:"page"`
+	PageSize int            `json:"page_size"`
+}
+
+type UpdateUserRoleRequest struct {
+	Role string `json:"role" validate:"required,oneof=user moderator admin"`
+}
+
+// AdminUpdateMovieRequest edits a stored movie; nil fields are left unchanged
+type AdminUpdateMovieRequest struct {
+	Title       *string    `json:"title,omitempty"`
+	Overview    *string    `json:"overview,omitempty"`
+	ReleaseDate *time.Time `json:"release_date,omitempty"`
+	PosterURL   *string    `json:"poster_url,omitempty"`
+	BackdropURL *string    `json:"backdrop_url,omitempty"`
+	Genres      []string   `json:"genres,omitempty"`
+	Runtime     *int       `json:"runtime,omitempty"`
+	Adult       *bool      `json:"adult,omitempty"`
+}
+
+// ProviderStatusDTO reports the health of a movie provider over its most recent calls
+type ProviderStatusDTO struct {
+	Provider            string     `json:"provider"`
+	State               string     `json:"state"` // closed, open or half_open
+	ConsecutiveFailures int        `json:"consecutive_failures"`
+	Calls               int        `json:"calls"`
+	Failures            int        `json:"failures"`
+	ErrorRate           float64    `json:"error_rate"`
+	AverageLatencyMS    int64      `json:"average_latency_ms"`
+	OpenedAt            *time.Time `json:"opened_at,omitempty"`
+	LastError           string     `json:"last_error,omitempty"`
+	LastErrorAt         *time.Time `json:"last_error_at,omitempty"`
+}
+
+// MovieCacheStatsDTO reports the movie cache counters of the instance that answered
+type MovieCacheStatsDTO struct {
+	Enabled            bool    `json:"enabled"`
+	Hits               int64   `json:"hits"`
+	NegativeHits       int64   `json:"negative_hits"`
+	Misses             int64   `json:"misses"`
+	HitRate            float64 `json:"hit_rate"`
+	SearchHits         int64   `json:"search_hits"`
+	SearchMisses       int64   `json:"search_misses"`
+	SearchHitRate      float64 `json:"search_hit_rate"`
+	Invalidations      int64   `json:"invalidations"`
+	MovieTTLSeconds    int64   `json:"movie_ttl_seconds"`
+	SearchTTLSeconds   int64   `json:"search_ttl_seconds"`
+	NotFoundTTLSeconds int64   `json:"not_found_ttl_seconds"`
+}
+
+// CatalogRefreshStatusDTO reports the background refresh of expired movies on the instance that answered
+type CatalogRefreshStatusDTO struct {
+	Enabled           bool       `json:"enabled"`
+	Running           bool       `json:"running"`
+	IntervalSeconds   int64      `json:"interval_seconds"`
+	HourlyBudget      int        `json:"hourly_budget"`
+	UsedThisHour      int        `json:"used_this_hour"`
+	BackedOffMovies   int        `json:"backed_off_movies"`
+	CurrentRunTotal   int        `json:"current_run_total,omitempty"`
+	CurrentRunDone    int        `json:"current_run_done,omitempty"`
+	LastRunStartedAt  *time.Time `json:"last_run_started_at,omitempty"`
+	LastRunFinishedAt *time.Time `json:"last_run_finished_at,omitempty"`
+	LastRunDurationMS int64      `json:"last_run_duration_ms"`
+	LastRunSelected   int        `json:"last_run_selected"`
+	LastRunRefreshed  int        `json:"last_run_refreshed"`
+	LastRunFailed     int        `json:"last_run_failed"`
+	LastError         string     `json:"last_error,omitempty"`
+	TotalRefreshed    int        `json:"total_refreshed"`
+	TotalFailed       int        `json:"total_failed"`
+}
+
+// OMDbKeyQuotaDTO is today's usage of one OMDb API key; the key itself is masked
+type OMDbKeyQuotaDTO struct {
+	Key       string `json:"key"`
+	Used      int    `json:"used"`
+	Remaining int    `json:"remaining"`
+	Exhausted bool   `json:"exhausted"`
+}
+
+// OMDbQuotaDTO reports the daily OMDb budget across all configured keys
+type OMDbQuotaDTO struct {
+	DailyLimit   int               `json:"daily_limit"` // per key, 0 means unlimited
+	Day          string            `json:"day"`
+	ResetsAt     time.Time         `json:"resets_at"`
+	Remaining    int               `json:"remaining"`
+	DatabaseOnly bool              `json:"database_only"`
+	Keys         []OMDbKeyQuotaDTO `json:"keys"`
+}
+
+// CatalogSeedStatusDTO reports the current or last catalog seeding run
+type CatalogSeedStatusDTO struct {
+	State      string     `json:"state"` // idle, running, completed or aborted
+	StartedAt  *time.Time `json:"started_at,omitempty"`
+	FinishedAt *time.Time `json:"finished_at,omitempty"`
+	Total      int        `json:"total"`
+	Processed  int        `json:"processed"`
+	Found      int        `json:"found"` // searches that returned at least one movie
+	Failed     int        `json:"failed"`
+	LastError  string     `json:"last_error,omitempty"`
+}
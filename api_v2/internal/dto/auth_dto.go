@@ -28,13 +28,20 @@ type UserDTO struct {
 	IsPrivate         bool      `json:"is_private"`
 	EmailVerified     bool      `json:"email_verified"`
 	Theme             string    `json:"theme"`
+	Role              string    `json:"role"`
 	CreatedAt         time.Time `json:"created_at"`
 	UpdatedAt         time.Time `json:"updated_at"`
 }
 
 type AuthResponseDTO struct {
-	Token string  `json:"token"`
-	User  UserDTO `json:"user"`
+	Token        string  `json:"token"`
+	RefreshToken string  `json:"refresh_token"`
+	ExpiresIn    int64   `json:"expires_in"`
+	User         UserDTO `json:"user"`
+}
+
+type RefreshTokenRequestDTO struct {
+	RefreshToken string `json:"refresh_token" validate:"required"`
 }
 
 type APIResponse struct {
@@ -48,3 +55,50 @@ type APIError struct {
 	Code    string `json:"code"`
 	Message string `json:"message"`
 }
+
+type ForgotPasswordRequestDTO struct {
+	Email string `json:"email" validate:"required,email"`
+}
+
+type ResetPasswordRequestDTO struct {
+	Token       string `json:"token" validate:"required"`
+	NewPassword string `json:"new_password" validate:"required,min=8"`
+}
+
+type VerifyEmailRequestDTO struct {
+	Token string `json:"token" validate:"required"`
+}
+
+type SessionDTO struct {
+	ID         uuid.UUID  `json:"id"`
+	UserAgent  *string    `json:"user_agent,omitempty"`
+	IPAddress  *string    `json:"ip_address,omitempty"`
+	CreatedAt  time.Time  `json:"created_at"`
+	LastUsedAt *time.Time `json:"last_used_at,omitempty"`
+	ExpiresAt  time.Time  `json:"expires_at"`
+	Current    bool       `json:"current"`
+}
+
+// CreatePersonalAccessTokenRequest creates a token for scripts and integrations.
+// Without ExpiresAt the token never expires.
+type CreatePersonalAccessTokenRequest struct {
+	Name      string     `json:"name" validate:"required,max=100"`
+	Scopes    []string   `json:"scopes" validate:"required,min=1"`
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+}
+
+type PersonalAccessTokenDTO struct {
+	ID          uuid.UUID  `json:"id"`
+	Name        string     `json:"name"`
+	TokenPrefix string     `json:"token_prefix"`
+	Scopes      []string   `json:"scopes"`
+	ExpiresAt   *time.Time `json:"expires_at,omitempty"`
+	LastUsedAt  *time.Time `json:"last_used_at,omitempty"`
+	CreatedAt   time.Time  `json:"created_at"`
+}
+
+// CreatedPersonalAccessTokenDTO is the only response that contains the token itself
+type CreatedPersonalAccessTokenDTO struct {
+	PersonalAccessTokenDTO
+	Token string `json:"token"`
+}
@@ -0,0 +1,33 @@
+package dto
+
+type TOTPSetupDTO struct {
+	Secret     string `json:"secret"`
+	OTPAuthURI string `json:"otpauth_uri"`
+}
+
+type TOTPCodeRequestDTO struct {
+	Code string `json:"code" validate:"required"`
+}
+
+type RecoveryCodesDTO struct {
+	RecoveryCodes []string `json:"recovery_codes"`
+}
+
+// MFAReauthRequestDTO confirms the identity of an authenticated user before a sensitive 2FA change.
+// Code accepts either a TOTP code or a recovery code.
+type MFAReauthRequestDTO struct {
+	Password string `json:"password" validate:"required"`
+	Code     string `json:"code" validate:"required"`
+}
+
+// MFAChallengeDTO is returned by login instead of a session when 2FA is enabled
+type MFAChallengeDTO struct {
+	MFARequired bool   `json:"mfa_required"`
+	MFAToken    string `json:"mfa_token"`
+	ExpiresIn   int64  `json:"expires_in"`
+}
+
+type MFAVerifyRequestDTO struct {
+	MFAToken string `json:"mfa_token" validate:"required"`
+	Code     string `json:"code" validate:"required"`
+}
@@ -10,6 +10,7 @@ type MovieDTO struct {
 	ID            uuid.UUID  `json:"id"`
 	ExternalAPIID string     `json:"external_api_id"`
 	Title         string     `json:"title"`
+	TitleType     string     `json:"title_type,omitempty"` // movie, series or episode
 	Overview      *string    `json:"overview,omitempty"`
 	ReleaseDate   *time.Time `json:"release_date,omitempty"`
 	PosterURL     *string    `json:"poster_url,omitempty"`
@@ -23,6 +24,48 @@ type MovieDTO struct {
 	UpdatedAt     time.Time  `json:"updated_at"`
 }
 
+// MovieDetailDTO is the movie page: the movie with its credits, awards and external ratings
+type MovieDetailDTO struct {
+	MovieDTO
+	TotalSeasons *int             `json:"total_seasons,omitempty"` // series only
+	Languages    []string         `json:"languages"`
+	Countries    []string         `json:"countries"`
+	Awards       *MovieAwardsDTO  `json:"awards,omitempty"`
+	BoxOffice    *int64           `json:"box_office,omitempty"` // US dollars
+	Directors    []MovieCreditDTO `json:"directors"`
+	Writers      []MovieCreditDTO `json:"writers"`
+	Cast         []MovieCreditDTO `json:"cast"`
+	Ratings      []MovieRatingDTO `json:"ratings"`
+}
+
+type MovieAwardsDTO struct {
+	Summary     string `json:"summary"`
+	Wins        *int   `json:"wins,omitempty"`
+	Nominations *int   `json:"nominations,omitempty"`
+}
+
+type MovieCreditDTO struct {
+	PersonID      uuid.UUID `json:"person_id"`
+	Name          string    `json:"name"`
+	CharacterName *string   `json:"character_name,omitempty"`
+	BillingOrder  int       `json:"billing_order"`
+}
+
+// MovieRatingDTO is a rating on an external source; score is the rating on a 0-100 scale
+type MovieRatingDTO struct {
+	Source string   `json:"source"` // imdb, rotten_tomatoes, metacritic or tmdb
+	Value  string   `json:"value"`
+	Score  *float64 `json:"score,omitempty"`
+	Votes  *int     `json:"votes,omitempty"`
+}
+
+type MovieBrowseResponse struct {
+	Movies   []MovieDTO `json:"movies"`
+	Total    int        `json:"total"`
+	Page     int        `json:"page"`
+	PageSize int        `json:"page_size"`
+}
+
 type GenreDTO struct {
 	ID   int    `json:"id"`
 	Name string `json:"name"`
@@ -38,18 +81,54 @@ type RandomMovieByGenreQuery struct {
 }
 
 type TMDbMovieResponse struct {
-	ID                  int           `json:"id"`
-	Title               string        `json:"title"`
-	Overview            string        `json:"overview"`
-	ReleaseDate         string        `json:"release_date"`
-	PosterPath          string        `json:"poster_path"`
-	BackdropPath        string        `json:"backdrop_path"`
-	Genres              []TMDbGenre   `json:"genres"`
-	Runtime             int           `json:"runtime"`
-	VoteAverage         float64       `json:"vote_average"`
-	VoteCount           int           `json:"vote_count"`
-	Adult               bool          `json:"adult"`
-	ProductionCompanies []interface{} `json:"production_companies"`
+	ID                  int            `json:"id"`
+	IMDbID              string         `json:"imdb_id"`
+	Title               string         `json:"title"`
+	Overview            string         `json:"overview"`
+	ReleaseDate         string         `json:"release_date"`
+	PosterPath          string         `json:"poster_path"`
+	BackdropPath        string         `json:"backdrop_path"`
+	Genres              []TMDbGenre    `json:"genres"`
+	Runtime             int            `json:"runtime"`
+	VoteAverage         float64        `json:"vote_average"`
+	VoteCount           int            `json:"vote_count"`
+	Adult               bool           `json:"adult"`
+	ProductionCompanies []interface{}  `json:"production_companies"`
+	ProductionCountries []TMDbCountry  `json:"production_countries"`
+	SpokenLanguages     []TMDbLanguage `json:"spoken_languages"`
+	Revenue             int64          `json:"revenue"` // US dollars
+	Credits             *TMDbCredits   `json:"credits,omitempty"`
+}
+
+type TMDbCountry struct {
+	ISO31661 string `json:"iso_3166_1"`
+	Name     string `json:"name"`
+}
+
+type TMDbLanguage struct {
+	ISO6391     string `json:"iso_639_1"`
+	EnglishName string `json:"english_name"`
+	Name        string `json:"name"`
+}
+
+// TMDbCredits is appended to the movie details with append_to_response=credits
+type TMDbCredits struct {
+	Cast []TMDbCastMember `json:"cast"`
+	Crew []TMDbCrewMember `json:"crew"`
+}
+
+type TMDbCastMember struct {
+	ID        int    `json:"id"`
+	Name      string `json:"name"`
+	Character string `json:"character"`
+	Order     int    `json:"order"`
+}
+
+type TMDbCrewMember struct {
+	ID         int    `json:"id"`
+	Name       string `json:"name"`
+	Job        string `json:"job"`
+	Department string `json:"department"`
 }
 
 type TMDbGenre struct {
@@ -87,3 +166,7 @@ type TMDbDiscoverResponse struct {
 	TotalPages   int                     `json:"total_pages"`
 	TotalResults int                     `json:"total_results"`
 }
+
+type TMDbFindResponse struct {
+	MovieResults []TMDbMovieSearchResult `json:"movie_results"`
+}
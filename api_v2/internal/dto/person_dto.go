@@ -0,0 +1,40 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type PersonDTO struct {
+	ID         uuid.UUID `json:"id"`
+	Name       string    `json:"name"`
+	MovieCount int       `json:"movie_count"` // stored movies crediting the person
+}
+
+type PersonSearchResponse struct {
+	People   []PersonDTO `json:"people"`
+	Total    int         `json:"total"`
+	Page     int         `json:"page"`
+	PageSize int         `json:"page_size"`
+}
+
+// PersonDetailDTO is a person with their filmography in our catalog, newest movies first.
+// A person credited with several roles on a movie appears once per role.
+type PersonDetailDTO struct {
+	ID          uuid.UUID             `json:"id"`
+	Name        string                `json:"name"`
+	Roles       []string              `json:"roles"` // director, writer and/or actor
+	Filmography []FilmographyEntryDTO `json:"filmography"`
+}
+
+type FilmographyEntryDTO struct {
+	MovieID       uuid.UUID  `json:"movie_id"`
+	ExternalAPIID string     `json:"external_api_id"`
+	Title         string     `json:"title"`
+	ReleaseDate   *time.Time `json:"release_date,omitempty"`
+	PosterURL     *string    `json:"poster_url,omitempty"`
+	Role          string     `json:"role"`
+	CharacterName *string    `json:"character_name,omitempty"`
+	BillingOrder  int        `json:"billing_order"`
+}
@@ -0,0 +1,61 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type SeriesSeasonsDTO struct {
+	SeriesID      uuid.UUID          `json:"series_id"`
+	ExternalAPIID string             `json:"external_api_id"`
+	Title         string             `json:"title"`
+	TotalSeasons  *int               `json:"total_seasons,omitempty"`
+	Seasons       []SeasonSummaryDTO `json:"seasons"`
+}
+
+// SeasonSummaryDTO is a season of a series; the episode count is only known once the season was synced
+type SeasonSummaryDTO struct {
+	SeasonNumber int        `json:"season_number"`
+	EpisodeCount *int       `json:"episode_count,omitempty"`
+	LastSyncAt   *time.Time `json:"last_sync_at,omitempty"`
+}
+
+type SeasonEpisodesDTO struct {
+	SeriesID      uuid.UUID    `json:"series_id"`
+	ExternalAPIID string       `json:"external_api_id"`
+	SeriesTitle   string       `json:"series_title"`
+	SeasonNumber  int          `json:"season_number"`
+	TotalSeasons  *int         `json:"total_seasons,omitempty"`
+	Episodes      []EpisodeDTO `json:"episodes"`
+}
+
+type EpisodeDTO struct {
+	ID            uuid.UUID  `json:"id"`
+	SeasonNumber  int        `json:"season_number"`
+	EpisodeNumber int        `json:"episode_number"`
+	ExternalAPIID *string    `json:"external_api_id,omitempty"`
+	Title         string     `json:"title"`
+	ReleaseDate   *time.Time `json:"release_date,omitempty"`
+	IMDbRating    *float64   `json:"imdb_rating,omitempty"`
+}
+
+// ToggleWatchedEpisodeRequest represents request to toggle an episode in the watched episodes
+type ToggleWatchedEpisodeRequest struct {
+	EpisodeID uuid.UUID `json:"episode_id" validate:"required"`
+}
+
+// SeriesProgressDTO is how far a user got in a series, counted over the synced seasons
+type SeriesProgressDTO struct {
+	SeriesID        uuid.UUID           `json:"series_id"`
+	Title           string              `json:"title"`
+	TotalEpisodes   int                 `json:"total_episodes"`
+	WatchedEpisodes int                 `json:"watched_episodes"`
+	NextEpisode     *EpisodeDTO         `json:"next_episode,omitempty"`
+	Watched         []WatchedEpisodeDTO `json:"watched"`
+}
+
+type WatchedEpisodeDTO struct {
+	Episode   EpisodeDTO `json:"episode"`
+	WatchedAt time.Time  `json:"watched_at"`
+}
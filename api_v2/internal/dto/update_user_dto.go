@@ -2,9 +2,35 @@ package dto
 
 // UpdateUserRequest represents request to update user profile
 type UpdateUserRequest struct {
+	Username          *string `json:"username" validate:"omitempty,min=3,max=30"`
 	DisplayName       *string `json:"display_name" validate:"omitempty,min=2,max=100"`
 	Bio               *string `json:"bio" validate:"omitempty,max=500"`
 	ProfilePictureURL *string `json:"profile_picture_url" validate:"omitempty,url"`
 	Theme             *string `json:"theme" validate:"omitempty,oneof=light dark"`
 	IsPrivate         *bool   `json:"is_private"`
 }
+
+// ChangePasswordRequest represents request to change the password of the authenticated user
+type ChangePasswordRequest struct {
+	CurrentPassword     string `json:"current_password" validate:"required"`
+	NewPassword         string `json:"new_password" validate:"required,min=8,max=72"`
+	RevokeOtherSessions bool   `json:"revoke_other_sessions"`
+}
+
+// ChangeEmailRequest represents request to change the email of the authenticated user.
+// The change only applies once the new address is confirmed.
+type ChangeEmailRequest struct {
+	NewEmail            string `json:"new_email" validate:"required,email"`
+	CurrentPassword     string `json:"current_password" validate:"required"`
+	RevokeOtherSessions bool   `json:"revoke_other_sessions"`
+}
+
+// ConfirmEmailChangeRequest represents request to confirm a new email with the token sent to it
+type ConfirmEmailChangeRequest struct {
+	Token string `json:"token" validate:"required"`
+}
+
+// DeleteAccountRequest represents request to delete the authenticated user's account
+type DeleteAccountRequest struct {
+	Password string `json:"password" validate:"required"`
+}
@@ -17,6 +17,8 @@ type UpdateSettingsRequest struct {
 	IsPrivate *bool   `json:"is_private"`
 }
 
+// PublicProfile is a profile as seen by another user.
+// Private accounts only show the basic card (Restricted) to users who don't follow them.
 type PublicProfile struct {
 	ID                uuid.UUID `json:"id"`
 	Username          string    `json:"username"`
@@ -24,11 +26,12 @@ type PublicProfile struct {
 	Bio               *string   `json:"bio,omitempty"`
 	ProfilePictureURL *string   `json:"profile_picture_url,omitempty"`
 	IsPrivate         bool      `json:"is_private"`
+	Restricted        bool      `json:"restricted"`
 	CreatedAt         time.Time `json:"created_at"`
-	ReviewCount       int       `json:"review_count,omitempty"`
-	MovieListCount    int       `json:"movie_list_count,omitempty"`
-	FollowerCount     int       `json:"follower_count,omitempty"`
-	FollowingCount    int       `json:"following_count,omitempty"`
+	ReviewCount       *int      `json:"review_count,omitempty"`
+	MovieListCount    *int      `json:"movie_list_count,omitempty"`
+	FollowerCount     *int      `json:"follower_count,omitempty"`
+	FollowingCount    *int      `json:"following_count,omitempty"`
 }
 
 type UserSearchResult struct {
@@ -42,18 +45,39 @@ type UserSearchResult struct {
 type UsernameAvailabilityResponse struct {
 	Username  string `json:"username"`
 	Available bool   `json:"available"`
+	// Reason explains why the username is unavailable: invalid, reserved or taken
+	Reason string `json:"reason,omitempty"`
+}
+
+type UserSearchResponse struct {
+	Users    []UserSearchResult `json:"users"`
+	Total    int                `json:"total"`
+	Page     int                `json:"page"`
+	PageSize int                `json:"page_size"`
 }
 
 type UserProfileResponse struct {
-	ID                uuid.UUID `json:"id"`
-	Username          string    `json:"username"`
-	Email             string    `json:"email"`
-	DisplayName       string    `json:"display_name"`
-	Bio               *string   `json:"bio,omitempty"`
-	ProfilePictureURL *string   `json:"profile_picture_url,omitempty"`
-	IsPrivate         bool      `json:"is_private"`
-	EmailVerified     bool      `json:"email_verified"`
-	Theme             string    `json:"theme"`
-	CreatedAt         time.Time `json:"created_at"`
-	UpdatedAt         time.Time `json:"updated_at"`
+	ID                uuid.UUID  `json:"id"`
+	Username          string     `json:"username"`
+	Email             string     `json:"email"`
+	DisplayName       string     `json:"display_name"`
+	Bio               *string    `json:"bio,omitempty"`
+	ProfilePictureURL *string    `json:"profile_picture_url,omitempty"`
+	IsPrivate         bool       `json:"is_private"`
+	EmailVerified     bool       `json:"email_verified"`
+	Theme             string     `json:"theme"`
+	UsernameChangedAt *time.Time `json:"username_changed_at,omitempty"`
+	CreatedAt         time.Time  `json:"created_at"`
+	UpdatedAt         time.Time  `json:"updated_at"`
+}
+
+type AccountDeletionResponse struct {
+	DeletionScheduledAt time.Time `json:"deletion_scheduled_at"`
+}
+
+// UserDataExport holds every row owned by a user, grouped by table name
+type UserDataExport struct {
+	UserID     uuid.UUID                           `json:"user_id"`
+	ExportedAt time.Time                           `json:"exported_at"`
+	Data       map[string][]map[string]interface{} `json:"data"`
 }
@@ -0,0 +1,412 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/EduardoMG12/cine/api_v2/internal/domain"
+	"github.com/EduardoMG12/cine/api_v2/internal/dto"
+	"github.com/EduardoMG12/cine/api_v2/internal/middleware"
+	"github.com/EduardoMG12/cine/api_v2/internal/usecase/admin"
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+)
+
+type AdminHandler struct {
+	listUsersUC      *admin.ListUsersUseCase
+	disableUserUC    *admin.DisableUserUseCase
+	enableUserUC     *admin.EnableUserUseCase
+	forceLogoutUC    *admin.ForceLogoutUseCase
+	updateUserRoleUC *admin.UpdateUserRoleUseCase
+	updateMovieUC    *admin.UpdateMovieUseCase
+	deleteMovieUC    *admin.DeleteMovieUseCase
+	providerStatusUC *admin.GetProviderStatusUseCase
+	cacheStatsUC     *admin.GetMovieCacheStatsUseCase
+	refreshStatusUC  *admin.GetCatalogRefreshStatusUseCase
+	omdbQuotaUC      *admin.GetOMDbQuotaUseCase
+	seedStatusUC     *admin.GetCatalogSeedStatusUseCase
+}
+
+func NewAdminHandler(
+	listUsersUC *admin.ListUsersUseCase,
+	disableUserUC *admin.DisableUserUseCase,
+	enableUserUC *admin.EnableUserUseCase,
+	forceLogoutUC *admin.ForceLogoutUseCase,
+	updateUserRoleUC *admin.UpdateUserRoleUseCase,
+	updateMovieUC *admin.UpdateMovieUseCase,
+	deleteMovieUC *admin.DeleteMovieUseCase,
+	providerStatusUC *admin.GetProviderStatusUseCase,
+	cacheStatsUC *admin.GetMovieCacheStatsUseCase,
+	refreshStatusUC *admin.GetCatalogRefreshStatusUseCase,
+	omdbQuotaUC *admin.GetOMDbQuotaUseCase,
+	seedStatusUC *admin.GetCatalogSeedStatusUseCase,
+) *AdminHandler {
+	return &AdminHandler{
+		listUsersUC:      listUsersUC,
+		disableUserUC:    disableUserUC,
+		enableUserUC:     enableUserUC,
+		forceLogoutUC:    forceLogoutUC,
+		updateUserRoleUC: updateUserRoleUC,
+		updateMovieUC:    updateMovieUC,
+		deleteMovieUC:    deleteMovieUC,
+		providerStatusUC: providerStatusUC,
+		cacheStatsUC:     cacheStatsUC,
+		refreshStatusUC:  refreshStatusUC,
+		omdbQuotaUC:      omdbQuotaUC,
+		seedStatusUC:     seedStatusUC,
+	}
+}
+
+// ListUsers godoc
+// @Summary List users
+// @Description List and search accounts (moderator or admin)
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Param q query string false "Search in username, email and display name"
+// @Param role query string false "Filter by role (user, moderator, admin)"
+// @Param disabled query bool false "Filter by disabled status"
+// @Param page query int false "Page number (default 1)"
+// @Param page_size query int false "Page size (default 20, max 100)"
+// @Success 200 {object} dto.APIResponse{data=dto.AdminUserListDTO}
+// @Failure 400 {object} dto.APIResponse
+// @Failure 401 {object} dto.APIResponse
+// @Failure 403 {object} dto.APIResponse
+// @Failure 500 {object} dto.APIResponse
+// @Router /api/v1/admin/users [get]
+func (h *AdminHandler) ListUsers(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	var disabled *bool
+	if disabledStr := query.Get("disabled"); disabledStr != "" {
+		value, err := strconv.ParseBool(disabledStr)
+		if err != nil {
+			sendErrorResponse(w, http.StatusBadRequest, "INVALID_FILTER", "disabled must be true or false")
+			return
+		}
+		disabled = &value
+	}
+
+	page, _ := strconv.Atoi(query.Get("page"))
+	pageSize, _ := strconv.Atoi(query.Get("page_size"))
+
+	result, err := h.listUsersUC.Execute(r.Context(), query.Get("q"), query.Get("role"), disabled, page, pageSize)
+	if err != nil {
+		if err.Error() == "invalid role" {
+			sendErrorResponse(w, http.StatusBadRequest, "INVALID_ROLE", "Role must be user, moderator or admin")
+			return
+		}
+		sendErrorResponse(w, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to list users")
+		return
+	}
+
+	sendSuccessResponse(w, http.StatusOK, "Users retrieved successfully", result)
+}
+
+// DisableUser godoc
+// @Summary Disable a user
+// @Description Block an account from logging in and revoke its sessions and personal access tokens (moderator or admin)
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "User ID"
+// @Success 200 {object} dto.APIResponse{data=dto.AdminUserDTO}
+// @Failure 400 {object} dto.APIResponse
+// @Failure 401 {object} dto.APIResponse
+// @Failure 403 {object} dto.APIResponse
+// @Failure 404 {object} dto.APIResponse
+// @Failure 500 {object} dto.APIResponse
+// @Router /api/v1/admin/users/{id}/disable [post]
+func (h *AdminHandler) DisableUser(w http.ResponseWriter, r *http.Request) {
+	actor, targetID, ok := adminTarget(w, r)
+	if !ok {
+		return
+	}
+
+	result, err := h.disableUserUC.Execute(r.Context(), actor, targetID)
+	if err != nil {
+		sendAdminError(w, err, "Failed to disable user")
+		return
+	}
+
+	sendSuccessResponse(w, http.StatusOK, "User disabled", result)
+}
+
+// EnableUser godoc
+// @Summary Enable a user
+// @Description Allow a disabled account to log in again (moderator or admin)
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "User ID"
+// @Success 200 {object} dto.APIResponse{data=dto.AdminUserDTO}
+// @Failure 400 {object} dto.APIResponse
+// @Failure 401 {object} dto.APIResponse
+// @Failure 403 {object} dto.APIResponse
+// @Failure 404 {object} dto.APIResponse
+// @Failure 500 {object} dto.APIResponse
+// @Router /api/v1/admin/users/{id}/enable [post]
+func (h *AdminHandler) EnableUser(w http.ResponseWriter, r *http.Request) {
+	actor, targetID, ok := adminTarget(w, r)
+	if !ok {
+		return
+	}
+
+	result, err := h.enableUserUC.Execute(r.Context(), actor, targetID)
+	if err != nil {
+		sendAdminError(w, err, "Failed to enable user")
+		return
+	}
+
+	sendSuccessResponse(w, http.StatusOK, "User enabled", result)
+}
+
+// ForceLogout godoc
+// @Summary Log a user out
+// @Description Revoke every session of an account (moderator or admin)
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "User ID"
+// @Success 200 {object} dto.APIResponse
+// @Failure 400 {object} dto.APIResponse
+// @Failure 401 {object} dto.APIResponse
+// @Failure 403 {object} dto.APIResponse
+// @Failure 404 {object} dto.APIResponse
+// @Failure 500 {object} dto.APIResponse
+// @Router /api/v1/admin/users/{id}/logout [post]
+func (h *AdminHandler) ForceLogout(w http.ResponseWriter, r *http.Request) {
+	actor, targetID, ok := adminTarget(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.forceLogoutUC.Execute(r.Context(), actor, targetID); err != nil {
+		sendAdminError(w, err, "Failed to log user out")
+		return
+	}
+
+	sendSuccessResponse(w, http.StatusOK, "User logged out from all sessions", nil)
+}
+
+// UpdateUserRole godoc
+// @Summary Change the role of a user
+// @Description Promote or demote an account (admin only)
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "User ID"
+// @Param request body dto.UpdateUserRoleRequest true "New role"
+// @Success 200 {object} dto.APIResponse{data=dto.AdminUserDTO}
+// @Failure 400 {object} dto.APIResponse
+// @Failure 401 {object} dto.APIResponse
+// @Failure 403 {object} dto.APIResponse
+// @Failure 404 {object} dto.APIResponse
+// @Failure 500 {object} dto.APIResponse
+// @Router /api/v1/admin/users/{id}/role [put]
+func (h *AdminHandler) UpdateUserRole(w http.ResponseWriter, r *http.Request) {
+	actor, targetID, ok := adminTarget(w, r)
+	if !ok {
+		return
+	}
+
+	var req dto.UpdateUserRoleRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		sendErrorResponse(w, http.StatusBadRequest, "INVALID_REQUEST", "Invalid request body")
+		return
+	}
+
+	result, err := h.updateUserRoleUC.Execute(r.Context(), actor, targetID, &req)
+	if err != nil {
+		if err.Error() == "invalid role" {
+			sendErrorResponse(w, http.StatusBadRequest, "INVALID_ROLE", "Role must be user, moderator or admin")
+			return
+		}
+		sendAdminError(w, err, "Failed to update role")
+		return
+	}
+
+	sendSuccessResponse(w, http.StatusOK, "Role updated", result)
+}
+
+// UpdateMovie godoc
+// @Summary Edit a movie
+// @Description Edit a stored movie; omitted fields are left unchanged (moderator or admin)
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Movie ID"
+// @Param request body dto.AdminUpdateMovieRequest true "Fields to change"
+// @Success 200 {object} dto.APIResponse{data=dto.MovieDTO}
+// @Failure 400 {object} dto.APIResponse
+// @Failure 401 {object} dto.APIResponse
+// @Failure 403 {object} dto.APIResponse
+// @Failure 404 {object} dto.APIResponse
+// @Failure 500 {object} dto.APIResponse
+// @Router /api/v1/admin/movies/{id} [patch]
+func (h *AdminHandler) UpdateMovie(w http.ResponseWriter, r *http.Request) {
+	movieID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		sendErrorResponse(w, http.StatusBadRequest, "INVALID_ID", "Invalid movie ID")
+		return
+	}
+
+	var req dto.AdminUpdateMovieRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		sendErrorResponse(w, http.StatusBadRequest, "INVALID_REQUEST", "Invalid request body")
+		return
+	}
+
+	result, err := h.updateMovieUC.Execute(r.Context(), movieID, &req)
+	if err != nil {
+		switch err.Error() {
+		case "movie not found":
+			sendErrorResponse(w, http.StatusNotFound, "MOVIE_NOT_FOUND", "Movie not found")
+		case "invalid title":
+			sendErrorResponse(w, http.StatusBadRequest, "INVALID_TITLE", "Title cannot be empty")
+		case "invalid runtime":
+			sendErrorResponse(w, http.StatusBadRequest, "INVALID_RUNTIME", "Runtime cannot be negative")
+		default:
+			sendErrorResponse(w, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to update movie")
+		}
+		return
+	}
+
+	sendSuccessResponse(w, http.StatusOK, "Movie updated", result)
+}
+
+// DeleteMovie godoc
+// @Summary Delete a movie
+// @Description Delete a stored movie (admin only)
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Movie ID"
+// @Success 200 {object} dto.APIResponse
+// @Failure 400 {object} dto.APIResponse
+// @Failure 401 {object} dto.APIResponse
+// @Failure 403 {object} dto.APIResponse
+// @Failure 404 {object} dto.APIResponse
+// @Failure 500 {object} dto.APIResponse
+// @Router /api/v1/admin/movies/{id} [delete]
+func (h *AdminHandler) DeleteMovie(w http.ResponseWriter, r *http.Request) {
+	movieID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		sendErrorResponse(w, http.StatusBadRequest, "INVALID_ID", "Invalid movie ID")
+		return
+	}
+
+	if err := h.deleteMovieUC.Execute(r.Context(), movieID); err != nil {
+		if err.Error() == "movie not found" {
+			sendErrorResponse(w, http.StatusNotFound, "MOVIE_NOT_FOUND", "Movie not found")
+			return
+		}
+		sendErrorResponse(w, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to delete movie")
+		return
+	}
+
+	sendSuccessResponse(w, http.StatusOK, "Movie deleted", nil)
+}
+
+// GetProviderStatus godoc
+// @Summary Movie provider status
+// @Description Report the circuit breaker state, error rate and average latency of each movie provider, in chain order (admin)
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} dto.APIResponse{data=[]dto.ProviderStatusDTO}
+// @Failure 401 {object} dto.APIResponse
+// @Failure 403 {object} dto.APIResponse
+// @Router /api/v1/admin/providers [get]
+func (h *AdminHandler) GetProviderStatus(w http.ResponseWriter, r *http.Request) {
+	sendSuccessResponse(w, http.StatusOK, "Provider status retrieved", h.providerStatusUC.Execute())
+}
+
+// GetMovieCacheStats godoc
+// @Summary Movie cache statistics
+// @Description Report the hit, miss and invalidation counters of the Redis movie cache since this instance started (admin)
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} dto.APIResponse{data=dto.MovieCacheStatsDTO}
+// @Failure 401 {object} dto.APIResponse
+// @Failure 403 {object} dto.APIResponse
+// @Router /api/v1/admin/movie-cache [get]
+func (h *AdminHandler) GetMovieCacheStats(w http.ResponseWriter, r *http.Request) {
+	sendSuccessResponse(w, http.StatusOK, "Movie cache statistics retrieved", h.cacheStatsUC.Execute())
+}
+
+// GetCatalogRefreshStatus godoc
+// @Summary Catalog refresh status
+// @Description Report the budget use, progress and last run of the background worker re-syncing expired movies (admin)
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} dto.APIResponse{data=dto.CatalogRefreshStatusDTO}
+// @Failure 401 {object} dto.APIResponse
+// @Failure 403 {object} dto.APIResponse
+// @Router /api/v1/admin/catalog-refresh [get]
+func (h *AdminHandler) GetCatalogRefreshStatus(w http.ResponseWriter, r *http.Request) {
+	sendSuccessResponse(w, http.StatusOK, "Catalog refresh status retrieved", h.refreshStatusUC.Execute())
+}
+
+// GetOMDbQuota godoc
+// @Summary OMDb quota
+// @Description Report today's calls and remaining budget of each OMDb API key (admin). While every key is exhausted the movie chain serves stored movies only.
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} dto.APIResponse{data=dto.OMDbQuotaDTO}
+// @Failure 401 {object} dto.APIResponse
+// @Failure 403 {object} dto.APIResponse
+// @Router /api/v1/admin/omdb-quota [get]
+func (h *AdminHandler) GetOMDbQuota(w http.ResponseWriter, r *http.Request) {
+	sendSuccessResponse(w, http.StatusOK, "OMDb quota retrieved", h.omdbQuotaUC.Execute(r.Context()))
+}
+
+// GetCatalogSeedStatus godoc
+// @Summary Catalog seeding status
+// @Description Report the state and the processed, saved and failed counts of the current or last catalog seeding run, on any instance (admin)
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} dto.APIResponse{data=dto.CatalogSeedStatusDTO}
+// @Failure 401 {object} dto.APIResponse
+// @Failure 403 {object} dto.APIResponse
+// @Router /api/v1/admin/catalog-seed [get]
+func (h *AdminHandler) GetCatalogSeedStatus(w http.ResponseWriter, r *http.Request) {
+	sendSuccessResponse(w, http.StatusOK, "Catalog seeding status retrieved", h.seedStatusUC.Execute(r.Context()))
+}
+
+// adminTarget reads the acting user and the ID of the user the action applies to
+func adminTarget(w http.ResponseWriter, r *http.Request) (*domain.User, uuid.UUID, bool) {
+	actor, ok := middleware.GetUserFromContext(r.Context())
+	if !ok {
+		sendErrorResponse(w, http.StatusUnauthorized, "UNAUTHORIZED", "User not authenticated")
+		return nil, uuid.Nil, false
+	}
+
+	targetID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		sendErrorResponse(w, http.StatusBadRequest, "INVALID_ID", "Invalid user ID")
+		return nil, uuid.Nil, false
+	}
+
+	return actor, targetID, true
+}
+
+func sendAdminError(w http.ResponseWriter, err error, fallbackMessage string) {
+	switch err.Error() {
+	case "user not found":
+		sendErrorResponse(w, http.StatusNotFound, "USER_NOT_FOUND", "User not found")
+	case "cannot modify own account":
+		sendErrorResponse(w, http.StatusBadRequest, "INVALID_TARGET", "You cannot perform this action on your own account")
+	case "insufficient permissions":
+		sendErrorResponse(w, http.StatusForbidden, "FORBIDDEN", "Only admins can manage staff accounts")
+	default:
+		sendErrorResponse(w, http.StatusInternalServerError, "INTERNAL_ERROR", fallbackMessage)
+	}
+}
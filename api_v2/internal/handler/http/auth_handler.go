@@ -15,6 +15,7 @@ type AuthHandler struct {
 	getMeUC     *auth.GetMeUseCase
 	logoutUC    *auth.LogoutUseCase
 	logoutAllUC *auth.LogoutAllUseCase
+	refreshUC   *auth.RefreshTokenUseCase
 }
 
 func NewAuthHandler(
@@ -23,6 +24,7 @@ func NewAuthHandler(
 	getMeUC *auth.GetMeUseCase,
 	logoutUC *auth.LogoutUseCase,
 	logoutAllUC *auth.LogoutAllUseCase,
+	refreshUC *auth.RefreshTokenUseCase,
 ) *AuthHandler {
 	return &AuthHandler{
 		registerUC:  registerUC,
@@ -30,6 +32,7 @@ func NewAuthHandler(
 		getMeUC:     getMeUC,
 		logoutUC:    logoutUC,
 		logoutAllUC: logoutAllUC,
+		refreshUC:   refreshUC,
 	}
 }
 
@@ -51,14 +54,16 @@ func (h *AuthHandler) Register(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	result, err := h.registerUC.Execute(req)
+	result, err := h.registerUC.Execute(r.Context(), req, sessionMetadata(r))
 	if err != nil {
 		if err.Error() == "email already registered" {
 			sendErrorResponse(w, http.StatusBadRequest, "EMAIL_EXISTS", "Email already registered")
 			return
 		}
-		if err.Error() == "username already taken" {
-			sendErrorResponse(w, http.StatusBadRequest, "USERNAME_EXISTS", "Username already taken")
+		if sendUsernameError(w, err) {
+			return
+		}
+		if sendPasswordPolicyError(w, err) {
 			return
 		}
 		sendErrorResponse(w, http.StatusInternalServerError, "REGISTRATION_FAILED", "Failed to register user")
@@ -70,14 +75,18 @@ func (h *AuthHandler) Register(w http.ResponseWriter, r *http.Request) {
 
 // Login godoc
 // @Summary Login user
-// @Description Authenticate user and return JWT token
+// @Description Authenticate user and return JWT token.
+// @Description When two-factor authentication is enabled, an MFA challenge is returned instead; complete it with /auth/mfa/verify.
 // @Tags auth
 // @Accept json
 // @Produce json
 // @Param request body dto.LoginRequestDTO true "Login credentials"
 // @Success 200 {object} dto.APIResponse{data=dto.AuthResponseDTO}
+// @Success 202 {object} dto.APIResponse{data=dto.MFAChallengeDTO}
 // @Failure 400 {object} dto.APIResponse
 // @Failure 401 {object} dto.APIResponse
+// @Failure 403 {object} dto.APIResponse
+// @Failure 429 {object} dto.APIResponse
 // @Failure 500 {object} dto.APIResponse
 // @Router /api/v1/auth/login [post]
 func (h *AuthHandler) Login(w http.ResponseWriter, r *http.Request) {
@@ -87,19 +96,68 @@ func (h *AuthHandler) Login(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	result, err := h.loginUC.Execute(req)
+	result, challenge, err := h.loginUC.Execute(r.Context(), req, sessionMetadata(r))
 	if err != nil {
+		if sendTooManyAttemptsError(w, err) {
+			return
+		}
 		if err.Error() == "invalid credentials" {
 			sendErrorResponse(w, http.StatusUnauthorized, "INVALID_CREDENTIALS", "Invalid email or password")
 			return
 		}
+		if err.Error() == "account disabled" {
+			sendErrorResponse(w, http.StatusForbidden, "ACCOUNT_DISABLED", "This account has been disabled")
+			return
+		}
 		sendErrorResponse(w, http.StatusInternalServerError, "LOGIN_FAILED", "Failed to login")
 		return
 	}
 
+	if challenge != nil {
+		sendSuccessResponse(w, http.StatusAccepted, "Two-factor authentication required", challenge)
+		return
+	}
+
 	sendSuccessResponse(w, http.StatusOK, "Login successful", result)
 }
 
+// Refresh godoc
+// @Summary Refresh access token
+// @Description Exchange a refresh token for a new access token and a new refresh token.
+// @Description Refresh tokens are single use: replaying one revokes the whole session.
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Param request body dto.RefreshTokenRequestDTO true "Refresh token"
+// @Success 200 {object} dto.APIResponse{data=dto.AuthResponseDTO}
+// @Failure 400 {object} dto.APIResponse
+// @Failure 401 {object} dto.APIResponse
+// @Failure 500 {object} dto.APIResponse
+// @Router /api/v1/auth/refresh [post]
+func (h *AuthHandler) Refresh(w http.ResponseWriter, r *http.Request) {
+	var req dto.RefreshTokenRequestDTO
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		sendErrorResponse(w, http.StatusBadRequest, "INVALID_REQUEST", "Invalid request body")
+		return
+	}
+
+	result, err := h.refreshUC.Execute(r.Context(), req)
+	if err != nil {
+		if err.Error() == "invalid refresh token" {
+			sendErrorResponse(w, http.StatusUnauthorized, "INVALID_REFRESH_TOKEN", "Invalid or expired refresh token")
+			return
+		}
+		if err.Error() == "refresh token reuse detected" {
+			sendErrorResponse(w, http.StatusUnauthorized, "REFRESH_TOKEN_REUSED", "Refresh token was already used, session revoked")
+			return
+		}
+		sendErrorResponse(w, http.StatusInternalServerError, "REFRESH_FAILED", "Failed to refresh token")
+		return
+	}
+
+	sendSuccessResponse(w, http.StatusOK, "Token refreshed", result)
+}
+
 // GetMe godoc
 // @Summary Get current user
 // @Description Get authenticated user information
@@ -116,7 +174,7 @@ func (h *AuthHandler) GetMe(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	result, err := h.getMeUC.Execute(userID)
+	result, err := h.getMeUC.Execute(r.Context(), userID)
 	if err != nil {
 		sendErrorResponse(w, http.StatusInternalServerError, "FAILED", "Failed to get user info")
 		return
@@ -143,7 +201,7 @@ func (h *AuthHandler) Logout(w http.ResponseWriter, r *http.Request) {
 	}
 
 	token := authHeader[len("Bearer "):]
-	if err := h.logoutUC.Execute(token); err != nil {
+	if err := h.logoutUC.Execute(r.Context(), token); err != nil {
 		sendErrorResponse(w, http.StatusInternalServerError, "LOGOUT_FAILED", "Failed to logout")
 		return
 	}
@@ -168,7 +226,7 @@ func (h *AuthHandler) LogoutAll(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if err := h.logoutAllUC.Execute(userID); err != nil {
+	if err := h.logoutAllUC.Execute(r.Context(), userID); err != nil {
 		sendErrorResponse(w, http.StatusInternalServerError, "LOGOUT_ALL_FAILED", "Failed to logout from all sessions")
 		return
 	}
@@ -0,0 +1,90 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/EduardoMG12/cine/api_v2/internal/dto"
+	"github.com/EduardoMG12/cine/api_v2/internal/middleware"
+	"github.com/EduardoMG12/cine/api_v2/internal/usecase/auth"
+)
+
+type EmailVerificationHandler struct {
+	verifyEmailUC        *auth.VerifyEmailUseCase
+	resendVerificationUC *auth.ResendVerificationUseCase
+}
+
+func NewEmailVerificationHandler(
+	verifyEmailUC *auth.VerifyEmailUseCase,
+	resendVerificationUC *auth.ResendVerificationUseCase,
+) *EmailVerificationHandler {
+	return &EmailVerificationHandler{
+		verifyEmailUC:        verifyEmailUC,
+		resendVerificationUC: resendVerificationUC,
+	}
+}
+
+// VerifyEmail godoc
+// @Summary Verify email address
+// @Description Confirm the account email using the token sent by email
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Param request body dto.VerifyEmailRequestDTO true "Verification token"
+// @Success 200 {object} dto.APIResponse
+// @Failure 400 {object} dto.APIResponse
+// @Failure 500 {object} dto.APIResponse
+// @Router /api/v1/auth/email/verify [post]
+func (h *EmailVerificationHandler) VerifyEmail(w http.ResponseWriter, r *http.Request) {
+	var req dto.VerifyEmailRequestDTO
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		sendErrorResponse(w, http.StatusBadRequest, "INVALID_REQUEST", "Invalid request body")
+		return
+	}
+
+	if err := h.verifyEmailUC.Execute(r.Context(), req); err != nil {
+		if err.Error() == "invalid verification token" {
+			sendErrorResponse(w, http.StatusBadRequest, "INVALID_VERIFICATION_TOKEN", "Invalid or expired verification token")
+			return
+		}
+		sendErrorResponse(w, http.StatusInternalServerError, "VERIFICATION_FAILED", "Failed to verify email")
+		return
+	}
+
+	sendSuccessResponse(w, http.StatusOK, "Email verified successfully", nil)
+}
+
+// ResendVerification godoc
+// @Summary Resend verification email
+// @Description Send a new verification link to the authenticated user's email (rate limited)
+// @Tags auth
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} dto.APIResponse
+// @Failure 400 {object} dto.APIResponse
+// @Failure 401 {object} dto.APIResponse
+// @Failure 429 {object} dto.APIResponse
+// @Failure 500 {object} dto.APIResponse
+// @Router /api/v1/auth/email/resend [post]
+func (h *EmailVerificationHandler) ResendVerification(w http.ResponseWriter, r *http.Request) {
+	userID, ok := middleware.GetUserIDFromContext(r.Context())
+	if !ok {
+		sendErrorResponse(w, http.StatusUnauthorized, "UNAUTHORIZED", "User not authenticated")
+		return
+	}
+
+	if err := h.resendVerificationUC.Execute(r.Context(), userID); err != nil {
+		if err.Error() == "email already verified" {
+			sendErrorResponse(w, http.StatusBadRequest, "EMAIL_ALREADY_VERIFIED", "Email is already verified")
+			return
+		}
+		if err.Error() == "too many verification requests" {
+			sendErrorResponse(w, http.StatusTooManyRequests, "TOO_MANY_REQUESTS", "Please wait before requesting another verification email")
+			return
+		}
+		sendErrorResponse(w, http.StatusInternalServerError, "RESEND_VERIFICATION_FAILED", "Failed to send verification email")
+		return
+	}
+
+	sendSuccessResponse(w, http.StatusOK, "Verification email sent", nil)
+}
@@ -51,7 +51,7 @@ func (h *FavoriteMovieHandler) ToggleFavoriteMovie(w http.ResponseWriter, r *htt
 		return
 	}
 
-	result, err := h.toggleFavoriteUC.Execute(userID, req.MovieID)
+	result, err := h.toggleFavoriteUC.Execute(r.Context(), userID, req.MovieID)
 	if err != nil {
 		if err.Error() == "movie not found" {
 			sendErrorResponse(w, http.StatusNotFound, "MOVIE_NOT_FOUND", err.Error())
@@ -81,7 +81,7 @@ func (h *FavoriteMovieHandler) GetFavoriteMovies(w http.ResponseWriter, r *http.
 		return
 	}
 
-	movies, err := h.getFavoriteUC.Execute(userID)
+	movies, err := h.getFavoriteUC.Execute(r.Context(), userID)
 	if err != nil {
 		sendErrorResponse(w, http.StatusInternalServerError, "INTERNAL_ERROR", err.Error())
 		return
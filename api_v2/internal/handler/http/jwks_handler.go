@@ -0,0 +1,33 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/EduardoMG12/cine/api_v2/internal/infrastructure"
+)
+
+type JWKSHandler struct {
+	jwtKeys *infrastructure.JWTKeyManager
+}
+
+func NewJWKSHandler(jwtKeys *infrastructure.JWTKeyManager) *JWKSHandler {
+	return &JWKSHandler{
+		jwtKeys: jwtKeys,
+	}
+}
+
+// GetJWKS godoc
+// @Summary JSON Web Key Set
+// @Description Public keys used to verify access tokens, identified by kid
+// @Tags system
+// @Produce json
+// @Success 200 {object} infrastructure.JSONWebKeySet
+// @Router /.well-known/jwks.json [get]
+func (h *JWKSHandler) GetJWKS(w http.ResponseWriter, r *http.Request) {
+	// Short cache so verifiers notice rotated keys quickly
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "public, max-age=300")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(h.jwtKeys.JWKS())
+}
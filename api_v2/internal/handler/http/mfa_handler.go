@@ -0,0 +1,227 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/EduardoMG12/cine/api_v2/internal/dto"
+	"github.com/EduardoMG12/cine/api_v2/internal/middleware"
+	"github.com/EduardoMG12/cine/api_v2/internal/usecase/auth"
+)
+
+type MFAHandler struct {
+	setupTOTPUC               *auth.SetupTOTPUseCase
+	confirmTOTPUC             *auth.ConfirmTOTPUseCase
+	disableTOTPUC             *auth.DisableTOTPUseCase
+	regenerateRecoveryCodesUC *auth.RegenerateRecoveryCodesUseCase
+	verifyMFALoginUC          *auth.VerifyMFALoginUseCase
+}
+
+func NewMFAHandler(
+	setupTOTPUC *auth.SetupTOTPUseCase,
+	confirmTOTPUC *auth.ConfirmTOTPUseCase,
+	disableTOTPUC *auth.DisableTOTPUseCase,
+	regenerateRecoveryCodesUC *auth.RegenerateRecoveryCodesUseCase,
+	verifyMFALoginUC *auth.VerifyMFALoginUseCase,
+) *MFAHandler {
+	return &MFAHandler{
+		setupTOTPUC:               setupTOTPUC,
+		confirmTOTPUC:             confirmTOTPUC,
+		disableTOTPUC:             disableTOTPUC,
+		regenerateRecoveryCodesUC: regenerateRecoveryCodesUC,
+		verifyMFALoginUC:          verifyMFALoginUC,
+	}
+}
+
+// SetupTOTP godoc
+// @Summary Start TOTP enrollment
+// @Description Generate a TOTP secret and otpauth URI for an authenticator app. 2FA is enabled once a code is confirmed.
+// @Tags mfa
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} dto.APIResponse{data=dto.TOTPSetupDTO}
+// @Failure 401 {object} dto.APIResponse
+// @Failure 409 {object} dto.APIResponse
+// @Failure 500 {object} dto.APIResponse
+// @Router /api/v1/auth/mfa/totp/setup [post]
+func (h *MFAHandler) SetupTOTP(w http.ResponseWriter, r *http.Request) {
+	userID, ok := middleware.GetUserIDFromContext(r.Context())
+	if !ok {
+		sendErrorResponse(w, http.StatusUnauthorized, "UNAUTHORIZED", "User not authenticated")
+		return
+	}
+
+	result, err := h.setupTOTPUC.Execute(r.Context(), userID)
+	if err != nil {
+		sendMFAError(w, err, "MFA_SETUP_FAILED", "Failed to set up two-factor authentication")
+		return
+	}
+
+	sendSuccessResponse(w, http.StatusOK, "Scan the secret with your authenticator app and confirm a code", result)
+}
+
+// ConfirmTOTP godoc
+// @Summary Confirm TOTP enrollment
+// @Description Enable 2FA with a code from the authenticator app. Returns the recovery codes, shown only once.
+// @Tags mfa
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body dto.TOTPCodeRequestDTO true "TOTP code"
+// @Success 200 {object} dto.APIResponse{data=dto.RecoveryCodesDTO}
+// @Failure 400 {object} dto.APIResponse
+// @Failure 401 {object} dto.APIResponse
+// @Failure 409 {object} dto.APIResponse
+// @Failure 500 {object} dto.APIResponse
+// @Router /api/v1/auth/mfa/totp/confirm [post]
+func (h *MFAHandler) ConfirmTOTP(w http.ResponseWriter, r *http.Request) {
+	userID, ok := middleware.GetUserIDFromContext(r.Context())
+	if !ok {
+		sendErrorResponse(w, http.StatusUnauthorized, "UNAUTHORIZED", "User not authenticated")
+		return
+	}
+
+	var req dto.TOTPCodeRequestDTO
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		sendErrorResponse(w, http.StatusBadRequest, "INVALID_REQUEST", "Invalid request body")
+		return
+	}
+
+	result, err := h.confirmTOTPUC.Execute(r.Context(), userID, req)
+	if err != nil {
+		sendMFAError(w, err, "MFA_SETUP_FAILED", "Failed to enable two-factor authentication")
+		return
+	}
+
+	sendSuccessResponse(w, http.StatusOK, "Two-factor authentication enabled", result)
+}
+
+// DisableTOTP godoc
+// @Summary Disable two-factor authentication
+// @Description Disable 2FA after re-authenticating with the password and a TOTP or recovery code
+// @Tags mfa
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body dto.MFAReauthRequestDTO true "Password and code"
+// @Success 200 {object} dto.APIResponse
+// @Failure 400 {object} dto.APIResponse
+// @Failure 401 {object} dto.APIResponse
+// @Failure 429 {object} dto.APIResponse
+// @Failure 500 {object} dto.APIResponse
+// @Router /api/v1/auth/mfa/disable [post]
+func (h *MFAHandler) DisableTOTP(w http.ResponseWriter, r *http.Request) {
+	userID, ok := middleware.GetUserIDFromContext(r.Context())
+	if !ok {
+		sendErrorResponse(w, http.StatusUnauthorized, "UNAUTHORIZED", "User not authenticated")
+		return
+	}
+
+	var req dto.MFAReauthRequestDTO
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		sendErrorResponse(w, http.StatusBadRequest, "INVALID_REQUEST", "Invalid request body")
+		return
+	}
+
+	if err := h.disableTOTPUC.Execute(r.Context(), userID, req, sessionMetadata(r)); err != nil {
+		sendMFAError(w, err, "MFA_DISABLE_FAILED", "Failed to disable two-factor authentication")
+		return
+	}
+
+	sendSuccessResponse(w, http.StatusOK, "Two-factor authentication disabled", nil)
+}
+
+// RegenerateRecoveryCodes godoc
+// @Summary Regenerate recovery codes
+// @Description Replace all recovery codes after re-authenticating with the password and a TOTP or recovery code
+// @Tags mfa
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body dto.MFAReauthRequestDTO true "Password and code"
+// @Success 200 {object} dto.APIResponse{data=dto.RecoveryCodesDTO}
+// @Failure 400 {object} dto.APIResponse
+// @Failure 401 {object} dto.APIResponse
+// @Failure 429 {object} dto.APIResponse
+// @Failure 500 {object} dto.APIResponse
+// @Router /api/v1/auth/mfa/recovery-codes [post]
+func (h *MFAHandler) RegenerateRecoveryCodes(w http.ResponseWriter, r *http.Request) {
+	userID, ok := middleware.GetUserIDFromContext(r.Context())
+	if !ok {
+		sendErrorResponse(w, http.StatusUnauthorized, "UNAUTHORIZED", "User not authenticated")
+		return
+	}
+
+	var req dto.MFAReauthRequestDTO
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		sendErrorResponse(w, http.StatusBadRequest, "INVALID_REQUEST", "Invalid request body")
+		return
+	}
+
+	result, err := h.regenerateRecoveryCodesUC.Execute(r.Context(), userID, req, sessionMetadata(r))
+	if err != nil {
+		sendMFAError(w, err, "RECOVERY_CODES_FAILED", "Failed to regenerate recovery codes")
+		return
+	}
+
+	sendSuccessResponse(w, http.StatusOK, "Recovery codes regenerated", result)
+}
+
+// VerifyLogin godoc
+// @Summary Complete two-factor login
+// @Description Exchange the MFA challenge returned by login and a TOTP or recovery code for a session
+// @Tags mfa
+// @Accept json
+// @Produce json
+// @Param request body dto.MFAVerifyRequestDTO true "MFA token and code"
+// @Success 200 {object} dto.APIResponse{data=dto.AuthResponseDTO}
+// @Failure 400 {object} dto.APIResponse
+// @Failure 401 {object} dto.APIResponse
+// @Failure 403 {object} dto.APIResponse
+// @Failure 429 {object} dto.APIResponse
+// @Failure 500 {object} dto.APIResponse
+// @Router /api/v1/auth/mfa/verify [post]
+func (h *MFAHandler) VerifyLogin(w http.ResponseWriter, r *http.Request) {
+	var req dto.MFAVerifyRequestDTO
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		sendErrorResponse(w, http.StatusBadRequest, "INVALID_REQUEST", "Invalid request body")
+		return
+	}
+
+	result, err := h.verifyMFALoginUC.Execute(r.Context(), req, sessionMetadata(r))
+	if err != nil {
+		if err.Error() == "invalid mfa token" {
+			sendErrorResponse(w, http.StatusUnauthorized, "INVALID_MFA_TOKEN", "Invalid or expired MFA token, please log in again")
+			return
+		}
+		if err.Error() == "account disabled" {
+			sendErrorResponse(w, http.StatusForbidden, "ACCOUNT_DISABLED", "This account has been disabled")
+			return
+		}
+		sendMFAError(w, err, "LOGIN_FAILED", "Failed to login")
+		return
+	}
+
+	sendSuccessResponse(w, http.StatusOK, "Login successful", result)
+}
+
+func sendMFAError(w http.ResponseWriter, err error, fallbackCode, fallbackMessage string) {
+	if sendTooManyAttemptsError(w, err) {
+		return
+	}
+
+	switch err.Error() {
+	case "invalid code":
+		sendErrorResponse(w, http.StatusUnauthorized, "INVALID_MFA_CODE", "Invalid authentication code")
+	case "invalid credentials":
+		sendErrorResponse(w, http.StatusUnauthorized, "INVALID_CREDENTIALS", "Invalid password")
+	case "two-factor authentication already enabled":
+		sendErrorResponse(w, http.StatusConflict, "MFA_ALREADY_ENABLED", "Two-factor authentication is already enabled")
+	case "two-factor authentication not set up":
+		sendErrorResponse(w, http.StatusBadRequest, "MFA_NOT_SET_UP", "Start the TOTP setup first")
+	case "two-factor authentication not enabled":
+		sendErrorResponse(w, http.StatusBadRequest, "MFA_NOT_ENABLED", "Two-factor authentication is not enabled")
+	default:
+		sendErrorResponse(w, http.StatusInternalServerError, fallbackCode, fallbackMessage)
+	}
+}
@@ -3,9 +3,11 @@ package http
 import (
 	"net/http"
 	"strconv"
+	"strings"
 
 	"github.com/EduardoMG12/cine/api_v2/internal/usecase/movie"
 	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
 )
 
 type MovieHandler struct {
@@ -14,6 +16,7 @@ type MovieHandler struct {
 	getRandomByGenreUC *movie.GetRandomMovieByGenreUseCase
 	searchMoviesUC     *movie.SearchMoviesUseCase
 	getTrendingUC      *movie.GetTrendingMoviesUseCase
+	browseMoviesUC     *movie.BrowseMoviesUseCase
 }
 
 func NewMovieHandler(
@@ -22,6 +25,7 @@ func NewMovieHandler(
 	getRandomByGenreUC *movie.GetRandomMovieByGenreUseCase,
 	searchMoviesUC *movie.SearchMoviesUseCase,
 	getTrendingUC *movie.GetTrendingMoviesUseCase,
+	browseMoviesUC *movie.BrowseMoviesUseCase,
 ) *MovieHandler {
 	return &MovieHandler{
 		getMovieByIDUC:     getMovieByIDUC,
@@ -29,16 +33,71 @@ func NewMovieHandler(
 		getRandomByGenreUC: getRandomByGenreUC,
 		searchMoviesUC:     searchMoviesUC,
 		getTrendingUC:      getTrendingUC,
+		browseMoviesUC:     browseMoviesUC,
 	}
 }
 
+// BrowseMovies godoc
+// @Summary Browse stored movies
+// @Description Browse the movies and series stored in our catalog, newest first, optionally by type, genre and people.
+// @Description with lists person IDs; only movies crediting every one of them are returned, in role when given.
+// @Tags movies
+// @Produce json
+// @Param type query string false "Title type: movie, series or episode"
+// @Param genre query string false "Genre name, e.g. Drama"
+// @Param with query string false "Comma separated person IDs (at most 5)"
+// @Param role query string false "Role of the people in with: director, writer or actor"
+// @Param page query int false "Page number (default 1)"
+// @Param page_size query int false "Page size (default 20, max 100)"
+// @Success 200 {object} dto.APIResponse{data=dto.MovieBrowseResponse}
+// @Failure 400 {object} dto.APIResponse
+// @Failure 500 {object} dto.APIResponse
+// @Router /api/v1/movies [get]
+func (h *MovieHandler) BrowseMovies(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	personIDs := []uuid.UUID{}
+	if with := query.Get("with"); with != "" {
+		for _, value := range strings.Split(with, ",") {
+			personID, err := uuid.Parse(strings.TrimSpace(value))
+			if err != nil {
+				sendErrorResponse(w, http.StatusBadRequest, "INVALID_PERSON_ID", "with must list person IDs separated by commas")
+				return
+			}
+			personIDs = append(personIDs, personID)
+		}
+	}
+
+	page, _ := strconv.Atoi(query.Get("page"))
+	pageSize, _ := strconv.Atoi(query.Get("page_size"))
+
+	result, err := h.browseMoviesUC.Execute(r.Context(), query.Get("type"), query.Get("genre"), personIDs, query.Get("role"), page, pageSize)
+	if err != nil {
+		switch err.Error() {
+		case "invalid type":
+			sendErrorResponse(w, http.StatusBadRequest, "INVALID_TYPE", "Type must be movie, series or episode")
+		case "invalid role":
+			sendErrorResponse(w, http.StatusBadRequest, "INVALID_ROLE", "Role must be director, writer or actor")
+		case "role requires a person":
+			sendErrorResponse(w, http.StatusBadRequest, "INVALID_FILTER", "role can only be used with with")
+		case "too many people":
+			sendErrorResponse(w, http.StatusBadRequest, "INVALID_FILTER", "with can list at most 5 people")
+		default:
+			sendErrorResponse(w, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to browse movies")
+		}
+		return
+	}
+
+	sendSuccessResponse(w, http.StatusOK, "Movies retrieved successfully", result)
+}
+
 // GetMovieByID godoc
 // @Summary Get movie by TMDb ID
-// @Description Get detailed information about a specific movie
+// @Description Get detailed information about a specific movie, with its directors, writers, cast, awards and external ratings
 // @Tags movies
 // @Produce json
 // @Param id path string true "TMDb Movie ID"
-// @Success 200 {object} dto.APIResponse{data=dto.MovieDTO}
+// @Success 200 {object} dto.APIResponse{data=dto.MovieDetailDTO}
 // @Failure 404 {object} dto.APIResponse
 // @Failure 500 {object} dto.APIResponse
 // @Router /api/v1/movies/{id} [get]
@@ -49,7 +108,7 @@ func (h *MovieHandler) GetMovieByID(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	result, err := h.getMovieByIDUC.Execute(tmdbID)
+	result, err := h.getMovieByIDUC.Execute(r.Context(), tmdbID)
 	if err != nil {
 		sendErrorResponse(w, http.StatusNotFound, "MOVIE_NOT_FOUND", err.Error())
 		return
@@ -68,7 +127,7 @@ func (h *MovieHandler) GetMovieByID(w http.ResponseWriter, r *http.Request) {
 // @Failure 500 {object} dto.APIResponse
 // @Router /api/v1/movies/random [get]
 func (h *MovieHandler) GetRandomMovie(w http.ResponseWriter, r *http.Request) {
-	result, err := h.getRandomUC.Execute()
+	result, err := h.getRandomUC.Execute(r.Context())
 	if err != nil {
 		sendErrorResponse(w, http.StatusNotFound, "NO_MOVIES", "No movies found in database")
 		return
@@ -95,7 +154,7 @@ func (h *MovieHandler) GetRandomMovieByGenre(w http.ResponseWriter, r *http.Requ
 		return
 	}
 
-	result, err := h.getRandomByGenreUC.Execute(genre)
+	result, err := h.getRandomByGenreUC.Execute(r.Context(), genre)
 	if err != nil {
 		sendErrorResponse(w, http.StatusNotFound, "NO_MOVIES", err.Error())
 		return
@@ -129,7 +188,7 @@ func (h *MovieHandler) SearchMovies(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
-	result, err := h.searchMoviesUC.Execute(query, page)
+	result, err := h.searchMoviesUC.Execute(r.Context(), query, page)
 	if err != nil {
 		sendErrorResponse(w, http.StatusInternalServerError, "SEARCH_FAILED", err.Error())
 		return
@@ -140,7 +199,7 @@ func (h *MovieHandler) SearchMovies(w http.ResponseWriter, r *http.Request) {
 
 // GetTrendingMovies godoc
 // @Summary Get trending movies
-// @Description Get a shuffled list of trending/popular movies from the local database
+// @Description Get a shuffled list of trending/popular movies from the local database. A small catalog is seeded in the background.
 // @Tags movies
 // @Produce json
 // @Success 200 {object} dto.APIResponse{data=[]dto.MovieDTO}
@@ -152,19 +211,17 @@ func (h *MovieHandler) SearchMovies(w http.ResponseWriter, r *http.Request) {
 // Therefore, this route simulates a "trending movies" feature using the local database.
 //
 // FLOW:
-//  1. First, it attempts to retrieve 100 movies directly from the database.
-//  2. If the database already contains 100 or more movies, the route returns them
-//     in random order so the user never sees the same sequence twice.
-//  3. If the database does not contain enough movies (< 100), the handler must:
-//     a) Iterate through an internal array containing around 300 initial movie name seeds
+//  1. The route returns up to 300 movies from the database in random order
+//     so the user never sees the same sequence twice.
+//  2. If the database does not contain enough movies (< 100), it also starts the
+//     catalog seeding job (worker.CatalogSeedJob) without waiting for it:
+//     a) The job iterates through an internal array containing around 300 initial movie name seeds
 //     (e.g. "Matrix", "Transformers", "Avatar"…) organized by genre categories.
-//     b) For each movie seed, call the existing SearchMoviesUseCase to fetch movie data
-//     from OMDb API.
-//     c) Each successful search result is saved into the database with the predefined
-//     genres from our seed data (since OMDb search doesn't return genres).
-//     d) After populating the database with search results, another fetch is performed
-//     to gather 100 movies.
-//  4. Finally, the route returns these movies shuffled to simulate a "trending" catalog.
+//     b) Each seed is searched through the movie chain by a small pool of workers, and the
+//     providers save the results into the database.
+//     c) A distributed lock keeps other instances from seeding at the same time, and
+//     GET /api/v1/admin/catalog-seed reports its progress.
+//  3. Later requests see the movies saved so far.
 //
 // PURPOSE:
 // - Build a local movie catalog progressively
@@ -172,7 +229,7 @@ func (h *MovieHandler) SearchMovies(w http.ResponseWriter, r *http.Request) {
 // - Ensure the user always sees a varied list of movies on the home screen
 // - Pre-populate genre data that OMDb doesn't provide in search results
 func (h *MovieHandler) GetTrendingMovies(w http.ResponseWriter, r *http.Request) {
-	result, err := h.getTrendingUC.Execute()
+	result, err := h.getTrendingUC.Execute(r.Context())
 	if err != nil {
 		sendErrorResponse(w, http.StatusInternalServerError, "TRENDING_FAILED", err.Error())
 		return
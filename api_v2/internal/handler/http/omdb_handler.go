@@ -37,7 +37,7 @@ func (h *OMDbHandler) GetMovieByIMDbID(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	movie, err := h.omdbService.GetMovieByExternalID(imdbID)
+	movie, err := h.omdbService.GetMovieByExternalID(r.Context(), imdbID)
 	if err != nil {
 		respondWithError(w, http.StatusNotFound, err.Error())
 		return
@@ -67,7 +67,7 @@ func (h *OMDbHandler) GetMovieByTitle(w http.ResponseWriter, r *http.Request) {
 
 	year := r.URL.Query().Get("year")
 
-	movie, err := h.omdbService.GetMovieByTitle(title, year)
+	movie, err := h.omdbService.GetMovieByTitle(r.Context(), title, year)
 	if err != nil {
 		respondWithError(w, http.StatusNotFound, err.Error())
 		return
@@ -97,7 +97,7 @@ func (h *OMDbHandler) SearchMovies(w http.ResponseWriter, r *http.Request) {
 
 	page := getPageFromQuery(r)
 
-	results, err := h.omdbService.SearchMovies(query, page)
+	results, err := h.omdbService.SearchMovies(r.Context(), query, page)
 	if err != nil {
 		respondWithError(w, http.StatusInternalServerError, err.Error())
 		return
@@ -129,7 +129,7 @@ func (h *OMDbHandler) SearchMoviesByType(w http.ResponseWriter, r *http.Request)
 	movieType := r.URL.Query().Get("type") // movie, series, episode
 	page := getPageFromQuery(r)
 
-	results, err := h.omdbService.SearchMoviesByType(query, movieType, page)
+	results, err := h.omdbService.SearchMoviesByType(r.Context(), query, movieType, page)
 	if err != nil {
 		respondWithError(w, http.StatusInternalServerError, err.Error())
 		return
@@ -148,7 +148,7 @@ func (h *OMDbHandler) SearchMoviesByType(w http.ResponseWriter, r *http.Request)
 // @Router /api/v1/omdb/test [get]
 func (h *OMDbHandler) TestConnection(w http.ResponseWriter, r *http.Request) {
 	// Test with a known movie (The Matrix)
-	movie, err := h.omdbService.GetMovieByExternalID("tt0133093")
+	movie, err := h.omdbService.GetMovieByExternalID(r.Context(), "tt0133093")
 	if err != nil {
 		respondWithJSON(w, http.StatusOK, map[string]interface{}{
 			"status":   "error",
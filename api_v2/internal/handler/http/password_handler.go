@@ -0,0 +1,88 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/EduardoMG12/cine/api_v2/internal/dto"
+	"github.com/EduardoMG12/cine/api_v2/internal/usecase/auth"
+)
+
+type PasswordHandler struct {
+	forgotPasswordUC *auth.ForgotPasswordUseCase
+	resetPasswordUC  *auth.ResetPasswordUseCase
+}
+
+func NewPasswordHandler(
+	forgotPasswordUC *auth.ForgotPasswordUseCase,
+	resetPasswordUC *auth.ResetPasswordUseCase,
+) *PasswordHandler {
+	return &PasswordHandler{
+		forgotPasswordUC: forgotPasswordUC,
+		resetPasswordUC:  resetPasswordUC,
+	}
+}
+
+// ForgotPassword godoc
+// @Summary Request a password reset
+// @Description Send a single-use password reset link to the given email.
+// @Description The response is the same whether or not the account exists.
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Param request body dto.ForgotPasswordRequestDTO true "Account email"
+// @Success 200 {object} dto.APIResponse
+// @Failure 400 {object} dto.APIResponse
+// @Failure 500 {object} dto.APIResponse
+// @Router /api/v1/auth/password/forgot [post]
+func (h *PasswordHandler) ForgotPassword(w http.ResponseWriter, r *http.Request) {
+	var req dto.ForgotPasswordRequestDTO
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		sendErrorResponse(w, http.StatusBadRequest, "INVALID_REQUEST", "Invalid request body")
+		return
+	}
+
+	if err := h.forgotPasswordUC.Execute(r.Context(), req); err != nil {
+		if err.Error() == "email is required" {
+			sendErrorResponse(w, http.StatusBadRequest, "INVALID_REQUEST", "Email is required")
+			return
+		}
+		sendErrorResponse(w, http.StatusInternalServerError, "FORGOT_PASSWORD_FAILED", "Failed to request password reset")
+		return
+	}
+
+	sendSuccessResponse(w, http.StatusOK, "If the email is registered, a reset link has been sent", nil)
+}
+
+// ResetPassword godoc
+// @Summary Reset password
+// @Description Set a new password using a reset token. All sessions of the account are revoked.
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Param request body dto.ResetPasswordRequestDTO true "Reset token and new password"
+// @Success 200 {object} dto.APIResponse
+// @Failure 400 {object} dto.APIResponse
+// @Failure 500 {object} dto.APIResponse
+// @Router /api/v1/auth/password/reset [post]
+func (h *PasswordHandler) ResetPassword(w http.ResponseWriter, r *http.Request) {
+	var req dto.ResetPasswordRequestDTO
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		sendErrorResponse(w, http.StatusBadRequest, "INVALID_REQUEST", "Invalid request body")
+		return
+	}
+
+	if err := h.resetPasswordUC.Execute(r.Context(), req); err != nil {
+		if err.Error() == "invalid reset token" {
+			sendErrorResponse(w, http.StatusBadRequest, "INVALID_RESET_TOKEN", "Invalid or expired reset token")
+			return
+		}
+		if sendPasswordPolicyError(w, err) {
+			return
+		}
+		sendErrorResponse(w, http.StatusInternalServerError, "RESET_PASSWORD_FAILED", "Failed to reset password")
+		return
+	}
+
+	sendSuccessResponse(w, http.StatusOK, "Password has been reset", nil)
+}
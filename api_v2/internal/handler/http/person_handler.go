@@ -0,0 +1,85 @@
+package http
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/EduardoMG12/cine/api_v2/internal/usecase/person"
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+)
+
+type PersonHandler struct {
+	getPersonUC    *person.GetPersonUseCase
+	searchPeopleUC *person.SearchPeopleUseCase
+}
+
+func NewPersonHandler(
+	getPersonUC *person.GetPersonUseCase,
+	searchPeopleUC *person.SearchPeopleUseCase,
+) *PersonHandler {
+	return &PersonHandler{
+		getPersonUC:    getPersonUC,
+		searchPeopleUC: searchPeopleUC,
+	}
+}
+
+// GetPerson godoc
+// @Summary Get a person
+// @Description Get a director, writer or actor with their filmography among the movies stored in our catalog, newest first
+// @Tags people
+// @Produce json
+// @Param id path string true "Person ID"
+// @Success 200 {object} dto.APIResponse{data=dto.PersonDetailDTO}
+// @Failure 400 {object} dto.APIResponse
+// @Failure 404 {object} dto.APIResponse
+// @Failure 500 {object} dto.APIResponse
+// @Router /api/v1/people/{id} [get]
+func (h *PersonHandler) GetPerson(w http.ResponseWriter, r *http.Request) {
+	personID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		sendErrorResponse(w, http.StatusBadRequest, "INVALID_ID", "Invalid person ID")
+		return
+	}
+
+	result, err := h.getPersonUC.Execute(r.Context(), personID)
+	if err != nil {
+		if err.Error() == "person not found" {
+			sendErrorResponse(w, http.StatusNotFound, "PERSON_NOT_FOUND", "Person not found")
+			return
+		}
+		sendErrorResponse(w, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to get person")
+		return
+	}
+
+	sendSuccessResponse(w, http.StatusOK, "Person retrieved successfully", result)
+}
+
+// SearchPeople godoc
+// @Summary Search people
+// @Description Search directors, writers and actors by name. Exact and prefix matches come first, then similar names.
+// @Tags people
+// @Produce json
+// @Param q query string true "Search query (at least 2 characters)"
+// @Param page query int false "Page number (default 1)"
+// @Param page_size query int false "Page size (default 20, max 50)"
+// @Success 200 {object} dto.APIResponse{data=dto.PersonSearchResponse}
+// @Failure 400 {object} dto.APIResponse
+// @Failure 500 {object} dto.APIResponse
+// @Router /api/v1/people/search [get]
+func (h *PersonHandler) SearchPeople(w http.ResponseWriter, r *http.Request) {
+	page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+	pageSize, _ := strconv.Atoi(r.URL.Query().Get("page_size"))
+
+	result, err := h.searchPeopleUC.Execute(r.Context(), r.URL.Query().Get("q"), page, pageSize)
+	if err != nil {
+		if err.Error() == "query too short" {
+			sendErrorResponse(w, http.StatusBadRequest, "INVALID_QUERY", "Search query must be at least 2 characters")
+			return
+		}
+		sendErrorResponse(w, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to search people")
+		return
+	}
+
+	sendSuccessResponse(w, http.StatusOK, "People retrieved successfully", result)
+}
@@ -0,0 +1,142 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/EduardoMG12/cine/api_v2/internal/domain"
+	"github.com/EduardoMG12/cine/api_v2/internal/dto"
+	"github.com/EduardoMG12/cine/api_v2/internal/middleware"
+	"github.com/EduardoMG12/cine/api_v2/internal/usecase/auth"
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+)
+
+type PersonalAccessTokenHandler struct {
+	listTokensUC  *auth.ListPersonalAccessTokensUseCase
+	createTokenUC *auth.CreatePersonalAccessTokenUseCase
+	revokeTokenUC *auth.RevokePersonalAccessTokenUseCase
+}
+
+func NewPersonalAccessTokenHandler(
+	listTokensUC *auth.ListPersonalAccessTokensUseCase,
+	createTokenUC *auth.CreatePersonalAccessTokenUseCase,
+	revokeTokenUC *auth.RevokePersonalAccessTokenUseCase,
+) *PersonalAccessTokenHandler {
+	return &PersonalAccessTokenHandler{
+		listTokensUC:  listTokensUC,
+		createTokenUC: createTokenUC,
+		revokeTokenUC: revokeTokenUC,
+	}
+}
+
+// ListTokens godoc
+// @Summary List personal access tokens
+// @Description List the personal access tokens of the authenticated user. The tokens themselves are never returned again.
+// @Tags auth
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} dto.APIResponse{data=[]dto.PersonalAccessTokenDTO}
+// @Failure 401 {object} dto.APIResponse
+// @Failure 500 {object} dto.APIResponse
+// @Router /api/v1/auth/tokens [get]
+func (h *PersonalAccessTokenHandler) ListTokens(w http.ResponseWriter, r *http.Request) {
+	userID, ok := middleware.GetUserIDFromContext(r.Context())
+	if !ok {
+		sendErrorResponse(w, http.StatusUnauthorized, "UNAUTHORIZED", "User not authenticated")
+		return
+	}
+
+	result, err := h.listTokensUC.Execute(r.Context(), userID)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to list tokens")
+		return
+	}
+
+	sendSuccessResponse(w, http.StatusOK, "Tokens retrieved successfully", result)
+}
+
+// CreateToken godoc
+// @Summary Create a personal access token
+// @Description Create a long-lived token for scripts and integrations, limited to the given scopes.
+// @Description Available scopes: profile:read, profile:write, watched:read, watched:write, favorites:read, favorites:write.
+// @Description The token is only shown in this response.
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body dto.CreatePersonalAccessTokenRequest true "Token name, scopes and optional expiration"
+// @Success 201 {object} dto.APIResponse{data=dto.CreatedPersonalAccessTokenDTO}
+// @Failure 400 {object} dto.APIResponse
+// @Failure 401 {object} dto.APIResponse
+// @Failure 500 {object} dto.APIResponse
+// @Router /api/v1/auth/tokens [post]
+func (h *PersonalAccessTokenHandler) CreateToken(w http.ResponseWriter, r *http.Request) {
+	userID, ok := middleware.GetUserIDFromContext(r.Context())
+	if !ok {
+		sendErrorResponse(w, http.StatusUnauthorized, "UNAUTHORIZED", "User not authenticated")
+		return
+	}
+
+	var req dto.CreatePersonalAccessTokenRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		sendErrorResponse(w, http.StatusBadRequest, "INVALID_REQUEST", "Invalid request body")
+		return
+	}
+
+	result, err := h.createTokenUC.Execute(r.Context(), userID, &req)
+	if err != nil {
+		switch err.Error() {
+		case "invalid token name":
+			sendErrorResponse(w, http.StatusBadRequest, "INVALID_NAME", "Name is required and must be at most 100 characters")
+		case "invalid scope":
+			sendErrorResponse(w, http.StatusBadRequest, "INVALID_SCOPE", "Scopes must be one or more of: "+strings.Join(domain.PersonalAccessTokenScopes, ", "))
+		case "expiration must be in the future":
+			sendErrorResponse(w, http.StatusBadRequest, "INVALID_EXPIRATION", "Expiration must be in the future")
+		default:
+			sendErrorResponse(w, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to create token")
+		}
+		return
+	}
+
+	sendSuccessResponse(w, http.StatusCreated, "Token created successfully", result)
+}
+
+// RevokeToken godoc
+// @Summary Revoke a personal access token
+// @Description Delete a personal access token of the authenticated user
+// @Tags auth
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Token ID"
+// @Success 200 {object} dto.APIResponse
+// @Failure 400 {object} dto.APIResponse
+// @Failure 401 {object} dto.APIResponse
+// @Failure 404 {object} dto.APIResponse
+// @Failure 500 {object} dto.APIResponse
+// @Router /api/v1/auth/tokens/{id} [delete]
+func (h *PersonalAccessTokenHandler) RevokeToken(w http.ResponseWriter, r *http.Request) {
+	userID, ok := middleware.GetUserIDFromContext(r.Context())
+	if !ok {
+		sendErrorResponse(w, http.StatusUnauthorized, "UNAUTHORIZED", "User not authenticated")
+		return
+	}
+
+	tokenID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		sendErrorResponse(w, http.StatusBadRequest, "INVALID_ID", "Invalid token ID")
+		return
+	}
+
+	if err := h.revokeTokenUC.Execute(r.Context(), userID, tokenID); err != nil {
+		if err.Error() == "personal access token not found" {
+			sendErrorResponse(w, http.StatusNotFound, "TOKEN_NOT_FOUND", "Token not found")
+			return
+		}
+		sendErrorResponse(w, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to revoke token")
+		return
+	}
+
+	sendSuccessResponse(w, http.StatusOK, "Token revoked", nil)
+}
@@ -0,0 +1,96 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/EduardoMG12/cine/api_v2/internal/infrastructure"
+	"github.com/EduardoMG12/cine/api_v2/internal/usecase/series"
+	"github.com/go-chi/chi/v5"
+)
+
+type SeriesHandler struct {
+	getSeasonsUC        *series.GetSeasonsUseCase
+	getSeasonEpisodesUC *series.GetSeasonEpisodesUseCase
+}
+
+func NewSeriesHandler(
+	getSeasonsUC *series.GetSeasonsUseCase,
+	getSeasonEpisodesUC *series.GetSeasonEpisodesUseCase,
+) *SeriesHandler {
+	return &SeriesHandler{
+		getSeasonsUC:        getSeasonsUC,
+		getSeasonEpisodesUC: getSeasonEpisodesUC,
+	}
+}
+
+// GetSeasons godoc
+// @Summary Get the seasons of a series
+// @Description List the seasons of a series by its external ID. Episode counts are given for the seasons already synced.
+// @Tags series
+// @Produce json
+// @Param id path string true "Series external ID (IMDb ID)"
+// @Success 200 {object} dto.APIResponse{data=dto.SeriesSeasonsDTO}
+// @Failure 400 {object} dto.APIResponse
+// @Failure 404 {object} dto.APIResponse
+// @Failure 500 {object} dto.APIResponse
+// @Router /api/v1/series/{id}/seasons [get]
+func (h *SeriesHandler) GetSeasons(w http.ResponseWriter, r *http.Request) {
+	externalID := chi.URLParam(r, "id")
+
+	result, err := h.getSeasonsUC.Execute(r.Context(), externalID)
+	if err != nil {
+		h.sendSeriesError(w, err)
+		return
+	}
+
+	sendSuccessResponse(w, http.StatusOK, "Seasons retrieved successfully", result)
+}
+
+// GetSeasonEpisodes godoc
+// @Summary Get the episodes of a season
+// @Description Get the episodes of a season of a series. Seasons are synced from OMDb and refreshed every 48 hours.
+// @Tags series
+// @Produce json
+// @Param id path string true "Series external ID (IMDb ID)"
+// @Param season path int true "Season number"
+// @Success 200 {object} dto.APIResponse{data=dto.SeasonEpisodesDTO}
+// @Failure 400 {object} dto.APIResponse
+// @Failure 404 {object} dto.APIResponse
+// @Failure 503 {object} dto.APIResponse
+// @Failure 500 {object} dto.APIResponse
+// @Router /api/v1/series/{id}/seasons/{season} [get]
+func (h *SeriesHandler) GetSeasonEpisodes(w http.ResponseWriter, r *http.Request) {
+	externalID := chi.URLParam(r, "id")
+	seasonNumber, err := strconv.Atoi(chi.URLParam(r, "season"))
+	if err != nil || seasonNumber < 1 {
+		sendErrorResponse(w, http.StatusBadRequest, "INVALID_SEASON", "Invalid season number")
+		return
+	}
+
+	result, err := h.getSeasonEpisodesUC.Execute(r.Context(), externalID, seasonNumber)
+	if err != nil {
+		h.sendSeriesError(w, err)
+		return
+	}
+
+	sendSuccessResponse(w, http.StatusOK, "Episodes retrieved successfully", result)
+}
+
+func (h *SeriesHandler) sendSeriesError(w http.ResponseWriter, err error) {
+	switch {
+	case err.Error() == "series not found":
+		sendErrorResponse(w, http.StatusNotFound, "SERIES_NOT_FOUND", "Series not found")
+	case err.Error() == "not a series":
+		sendErrorResponse(w, http.StatusBadRequest, "NOT_A_SERIES", "Title is not a series")
+	case err.Error() == "invalid season":
+		sendErrorResponse(w, http.StatusBadRequest, "INVALID_SEASON", "Invalid season number")
+	case err.Error() == "season not found":
+		sendErrorResponse(w, http.StatusNotFound, "SEASON_NOT_FOUND", "Season not found")
+	case errors.Is(err, infrastructure.ErrProviderUnavailable):
+		sendErrorResponse(w, http.StatusServiceUnavailable, "PROVIDER_UNAVAILABLE", "Episodes are temporarily unavailable")
+	default:
+		sendErrorResponse(w, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to get series")
+	}
+}
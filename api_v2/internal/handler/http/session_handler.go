@@ -0,0 +1,90 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/EduardoMG12/cine/api_v2/internal/middleware"
+	"github.com/EduardoMG12/cine/api_v2/internal/usecase/auth"
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+)
+
+type SessionHandler struct {
+	listSessionsUC  *auth.ListSessionsUseCase
+	revokeSessionUC *auth.RevokeSessionUseCase
+}
+
+func NewSessionHandler(
+	listSessionsUC *auth.ListSessionsUseCase,
+	revokeSessionUC *auth.RevokeSessionUseCase,
+) *SessionHandler {
+	return &SessionHandler{
+		listSessionsUC:  listSessionsUC,
+		revokeSessionUC: revokeSessionUC,
+	}
+}
+
+// ListSessions godoc
+// @Summary List active sessions
+// @Description List the devices where the authenticated user is logged in
+// @Tags auth
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} dto.APIResponse{data=[]dto.SessionDTO}
+// @Failure 401 {object} dto.APIResponse
+// @Failure 500 {object} dto.APIResponse
+// @Router /api/v1/auth/sessions [get]
+func (h *SessionHandler) ListSessions(w http.ResponseWriter, r *http.Request) {
+	userID, ok := middleware.GetUserIDFromContext(r.Context())
+	if !ok {
+		sendErrorResponse(w, http.StatusUnauthorized, "UNAUTHORIZED", "User not authenticated")
+		return
+	}
+	currentSessionID, _ := middleware.GetSessionIDFromContext(r.Context())
+
+	result, err := h.listSessionsUC.Execute(r.Context(), userID, currentSessionID)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to list sessions")
+		return
+	}
+
+	sendSuccessResponse(w, http.StatusOK, "Sessions retrieved successfully", result)
+}
+
+// RevokeSession godoc
+// @Summary Revoke a session
+// @Description Log out a single device of the authenticated user
+// @Tags auth
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Session ID"
+// @Success 200 {object} dto.APIResponse
+// @Failure 400 {object} dto.APIResponse
+// @Failure 401 {object} dto.APIResponse
+// @Failure 404 {object} dto.APIResponse
+// @Failure 500 {object} dto.APIResponse
+// @Router /api/v1/auth/sessions/{id} [delete]
+func (h *SessionHandler) RevokeSession(w http.ResponseWriter, r *http.Request) {
+	userID, ok := middleware.GetUserIDFromContext(r.Context())
+	if !ok {
+		sendErrorResponse(w, http.StatusUnauthorized, "UNAUTHORIZED", "User not authenticated")
+		return
+	}
+
+	sessionID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		sendErrorResponse(w, http.StatusBadRequest, "INVALID_ID", "Invalid session ID")
+		return
+	}
+
+	if err := h.revokeSessionUC.Execute(r.Context(), userID, sessionID); err != nil {
+		if err.Error() == "session not found" {
+			sendErrorResponse(w, http.StatusNotFound, "SESSION_NOT_FOUND", "Session not found")
+			return
+		}
+		sendErrorResponse(w, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to revoke session")
+		return
+	}
+
+	sendSuccessResponse(w, http.StatusOK, "Session revoked", nil)
+}
@@ -1,35 +1,74 @@
 package http
 
 import (
+	"archive/zip"
 	"encoding/json"
+	"fmt"
 	"net/http"
+	"sort"
+	"strconv"
 
 	"github.com/EduardoMG12/cine/api_v2/internal/dto"
 	"github.com/EduardoMG12/cine/api_v2/internal/middleware"
 	"github.com/EduardoMG12/cine/api_v2/internal/usecase/user"
+	"github.com/go-chi/chi/v5"
 )
 
 type UserHandler struct {
-	updateUserUC *user.UpdateUserUseCase
+	updateUserUC         *user.UpdateUserUseCase
+	changePasswordUC     *user.ChangePasswordUseCase
+	requestEmailChangeUC *user.RequestEmailChangeUseCase
+	confirmEmailChangeUC *user.ConfirmEmailChangeUseCase
+	deleteAccountUC      *user.DeleteAccountUseCase
+	exportUserDataUC     *user.ExportUserDataUseCase
+	checkUsernameUC      *user.CheckUsernameUseCase
+	searchUsersUC        *user.SearchUsersUseCase
+	getPublicProfileUC   *user.GetPublicProfileUseCase
+	getProfileWatchedUC  *user.GetProfileWatchedMoviesUseCase
+	getProfileFavoriteUC *user.GetProfileFavoriteMoviesUseCase
 }
 
-func NewUserHandler(updateUserUC *user.UpdateUserUseCase) *UserHandler {
+func NewUserHandler(
+	updateUserUC *user.UpdateUserUseCase,
+	changePasswordUC *user.ChangePasswordUseCase,
+	requestEmailChangeUC *user.RequestEmailChangeUseCase,
+	confirmEmailChangeUC *user.ConfirmEmailChangeUseCase,
+	deleteAccountUC *user.DeleteAccountUseCase,
+	exportUserDataUC *user.ExportUserDataUseCase,
+	checkUsernameUC *user.CheckUsernameUseCase,
+	searchUsersUC *user.SearchUsersUseCase,
+	getPublicProfileUC *user.GetPublicProfileUseCase,
+	getProfileWatchedUC *user.GetProfileWatchedMoviesUseCase,
+	getProfileFavoriteUC *user.GetProfileFavoriteMoviesUseCase,
+) *UserHandler {
 	return &UserHandler{
-		updateUserUC: updateUserUC,
+		updateUserUC:         updateUserUC,
+		changePasswordUC:     changePasswordUC,
+		requestEmailChangeUC: requestEmailChangeUC,
+		confirmEmailChangeUC: confirmEmailChangeUC,
+		deleteAccountUC:      deleteAccountUC,
+		exportUserDataUC:     exportUserDataUC,
+		checkUsernameUC:      checkUsernameUC,
+		searchUsersUC:        searchUsersUC,
+		getPublicProfileUC:   getPublicProfileUC,
+		getProfileWatchedUC:  getProfileWatchedUC,
+		getProfileFavoriteUC: getProfileFavoriteUC,
 	}
 }
 
 // UpdateUser godoc
 // @Summary Update user profile
-// @Description Update the authenticated user's profile information
+// @Description Update the authenticated user's profile information.
+// @Description The username can only be changed once per cooldown period and the previous one stays reserved for the user for a while.
 // @Tags users
 // @Accept json
 // @Produce json
 // @Security BearerAuth
 // @Param request body dto.UpdateUserRequest true "User update data"
 // @Success 200 {object} dto.APIResponse{data=dto.UserProfileResponse} "User profile updated successfully"
-// @Failure 400 {object} dto.APIResponse "Invalid request body"
+// @Failure 400 {object} dto.APIResponse "Invalid request body or username"
 // @Failure 401 {object} dto.APIResponse "User not authenticated"
+// @Failure 429 {object} dto.APIResponse "Username changed too recently"
 // @Failure 500 {object} dto.APIResponse "Internal server error"
 // @Router /api/v1/users/me [patch]
 func (h *UserHandler) UpdateUser(w http.ResponseWriter, r *http.Request) {
@@ -45,11 +84,414 @@ func (h *UserHandler) UpdateUser(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	result, err := h.updateUserUC.Execute(userID, &req)
+	result, err := h.updateUserUC.Execute(r.Context(), userID, &req)
 	if err != nil {
+		if sendUsernameError(w, err) {
+			return
+		}
+		if err.Error() == "username change cooldown" {
+			sendErrorResponse(w, http.StatusTooManyRequests, "USERNAME_CHANGE_COOLDOWN", "Username was changed recently, try again later")
+			return
+		}
 		sendErrorResponse(w, http.StatusInternalServerError, "INTERNAL_ERROR", err.Error())
 		return
 	}
 
 	sendSuccessResponse(w, http.StatusOK, "User profile updated successfully", result)
 }
+
+// CheckUsername godoc
+// @Summary Check username availability
+// @Description Tell whether a username can be registered. The username is normalized (trimmed, lowercased) like on registration.
+// @Tags users
+// @Produce json
+// @Param username query string true "Username to check"
+// @Success 200 {object} dto.APIResponse{data=dto.UsernameAvailabilityResponse}
+// @Failure 400 {object} dto.APIResponse
+// @Failure 500 {object} dto.APIResponse
+// @Router /api/v1/users/check-username [get]
+func (h *UserHandler) CheckUsername(w http.ResponseWriter, r *http.Request) {
+	username := r.URL.Query().Get("username")
+	if username == "" {
+		sendErrorResponse(w, http.StatusBadRequest, "INVALID_USERNAME", "Username is required")
+		return
+	}
+
+	result, err := h.checkUsernameUC.Execute(r.Context(), username)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to check username")
+		return
+	}
+
+	sendSuccessResponse(w, http.StatusOK, "Username checked", result)
+}
+
+// SearchUsers godoc
+// @Summary Search users
+// @Description Search users by username prefix or by similar username or display name.
+// @Description Private accounts only show up when searched by their exact username.
+// @Tags users
+// @Produce json
+// @Param q query string true "Search query (at least 2 characters)"
+// @Param page query int false "Page number (default 1)"
+// @Param page_size query int false "Page size (default 20, max 50)"
+// @Success 200 {object} dto.APIResponse{data=dto.UserSearchResponse}
+// @Failure 400 {object} dto.APIResponse
+// @Failure 500 {object} dto.APIResponse
+// @Router /api/v1/users/search [get]
+func (h *UserHandler) SearchUsers(w http.ResponseWriter, r *http.Request) {
+	page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+	pageSize, _ := strconv.Atoi(r.URL.Query().Get("page_size"))
+
+	result, err := h.searchUsersUC.Execute(r.Context(), r.URL.Query().Get("q"), page, pageSize)
+	if err != nil {
+		if err.Error() == "query too short" {
+			sendErrorResponse(w, http.StatusBadRequest, "INVALID_QUERY", "Search query must be at least 2 characters")
+			return
+		}
+		sendErrorResponse(w, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to search users")
+		return
+	}
+
+	sendSuccessResponse(w, http.StatusOK, "Users retrieved successfully", result)
+}
+
+// GetPublicProfile godoc
+// @Summary Get public profile
+// @Description Get a user's public profile with review, list, follower and following counts.
+// @Description Private accounts only show the basic card (restricted=true) unless the viewer is the owner or a follower.
+// @Tags users
+// @Produce json
+// @Security BearerAuth
+// @Param username path string true "Username"
+// @Success 200 {object} dto.APIResponse{data=dto.PublicProfile}
+// @Failure 401 {object} dto.APIResponse "Invalid token"
+// @Failure 404 {object} dto.APIResponse "User not found"
+// @Failure 500 {object} dto.APIResponse
+// @Router /api/v1/users/{username} [get]
+func (h *UserHandler) GetPublicProfile(w http.ResponseWriter, r *http.Request) {
+	viewerID, _ := middleware.GetUserIDFromContext(r.Context())
+
+	result, err := h.getPublicProfileUC.Execute(r.Context(), chi.URLParam(r, "username"), viewerID)
+	if err != nil {
+		sendProfileError(w, err)
+		return
+	}
+
+	sendSuccessResponse(w, http.StatusOK, "Profile retrieved successfully", result)
+}
+
+// GetProfileWatchedMovies godoc
+// @Summary Get a user's watched movies
+// @Description Get the watched list of a user. Private accounts only share it with their owner and followers.
+// @Tags users
+// @Produce json
+// @Security BearerAuth
+// @Param username path string true "Username"
+// @Success 200 {object} dto.APIResponse{data=[]dto.WatchedMovieWithDetailsDTO}
+// @Failure 401 {object} dto.APIResponse "Invalid token"
+// @Failure 403 {object} dto.APIResponse "Profile is private"
+// @Failure 404 {object} dto.APIResponse "User not found"
+// @Failure 500 {object} dto.APIResponse
+// @Router /api/v1/users/{username}/watched [get]
+func (h *UserHandler) GetProfileWatchedMovies(w http.ResponseWriter, r *http.Request) {
+	viewerID, _ := middleware.GetUserIDFromContext(r.Context())
+
+	result, err := h.getProfileWatchedUC.Execute(r.Context(), chi.URLParam(r, "username"), viewerID)
+	if err != nil {
+		sendProfileError(w, err)
+		return
+	}
+
+	sendSuccessResponse(w, http.StatusOK, "Watched movies retrieved successfully", result)
+}
+
+// GetProfileFavoriteMovies godoc
+// @Summary Get a user's favorite movies
+// @Description Get the favorite movies of a user. Private accounts only share them with their owner and followers.
+// @Tags users
+// @Produce json
+// @Security BearerAuth
+// @Param username path string true "Username"
+// @Success 200 {object} dto.APIResponse{data=[]dto.FavoriteMovieWithDetailsDTO}
+// @Failure 401 {object} dto.APIResponse "Invalid token"
+// @Failure 403 {object} dto.APIResponse "Profile is private"
+// @Failure 404 {object} dto.APIResponse "User not found"
+// @Failure 500 {object} dto.APIResponse
+// @Router /api/v1/users/{username}/favorites [get]
+func (h *UserHandler) GetProfileFavoriteMovies(w http.ResponseWriter, r *http.Request) {
+	viewerID, _ := middleware.GetUserIDFromContext(r.Context())
+
+	result, err := h.getProfileFavoriteUC.Execute(r.Context(), chi.URLParam(r, "username"), viewerID)
+	if err != nil {
+		sendProfileError(w, err)
+		return
+	}
+
+	sendSuccessResponse(w, http.StatusOK, "Favorite movies retrieved successfully", result)
+}
+
+func sendProfileError(w http.ResponseWriter, err error) {
+	switch err.Error() {
+	case "user not found":
+		sendErrorResponse(w, http.StatusNotFound, "USER_NOT_FOUND", "User not found")
+	case "profile is private":
+		sendErrorResponse(w, http.StatusForbidden, "PROFILE_PRIVATE", "This profile is private")
+	default:
+		sendErrorResponse(w, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to get profile")
+	}
+}
+
+// ChangePassword godoc
+// @Summary Change password
+// @Description Change the authenticated user's password. Requires the current password; other sessions can be logged out.
+// @Tags users
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body dto.ChangePasswordRequest true "Current and new password"
+// @Success 200 {object} dto.APIResponse "Password changed successfully"
+// @Failure 400 {object} dto.APIResponse "Invalid request body or weak password"
+// @Failure 401 {object} dto.APIResponse "User not authenticated or wrong current password"
+// @Failure 500 {object} dto.APIResponse "Internal server error"
+// @Router /api/v1/users/me/password [post]
+func (h *UserHandler) ChangePassword(w http.ResponseWriter, r *http.Request) {
+	userID, ok := middleware.GetUserIDFromContext(r.Context())
+	if !ok {
+		sendErrorResponse(w, http.StatusUnauthorized, "UNAUTHORIZED", "User not authenticated")
+		return
+	}
+	sessionID, _ := middleware.GetSessionIDFromContext(r.Context())
+
+	var req dto.ChangePasswordRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		sendErrorResponse(w, http.StatusBadRequest, "INVALID_REQUEST", "Invalid request body")
+		return
+	}
+
+	if err := h.changePasswordUC.Execute(r.Context(), userID, sessionID, &req); err != nil {
+		if err.Error() == "invalid current password" {
+			sendErrorResponse(w, http.StatusUnauthorized, "INVALID_CREDENTIALS", "Current password is incorrect")
+			return
+		}
+		if err.Error() == "password unchanged" {
+			sendErrorResponse(w, http.StatusBadRequest, "PASSWORD_UNCHANGED", "New password must be different from the current one")
+			return
+		}
+		if sendPasswordPolicyError(w, err) {
+			return
+		}
+		sendErrorResponse(w, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to change password")
+		return
+	}
+
+	sendSuccessResponse(w, http.StatusOK, "Password changed successfully", nil)
+}
+
+// RequestEmailChange godoc
+// @Summary Request email change
+// @Description Send a confirmation link to the new address. The email changes once the link is confirmed.
+// @Tags users
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body dto.ChangeEmailRequest true "New email and current password"
+// @Success 202 {object} dto.APIResponse "Confirmation email sent"
+// @Failure 400 {object} dto.APIResponse "Invalid request body or email"
+// @Failure 401 {object} dto.APIResponse "User not authenticated or wrong current password"
+// @Failure 500 {object} dto.APIResponse "Internal server error"
+// @Router /api/v1/users/me/email [post]
+func (h *UserHandler) RequestEmailChange(w http.ResponseWriter, r *http.Request) {
+	userID, ok := middleware.GetUserIDFromContext(r.Context())
+	if !ok {
+		sendErrorResponse(w, http.StatusUnauthorized, "UNAUTHORIZED", "User not authenticated")
+		return
+	}
+	sessionID, _ := middleware.GetSessionIDFromContext(r.Context())
+
+	var req dto.ChangeEmailRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		sendErrorResponse(w, http.StatusBadRequest, "INVALID_REQUEST", "Invalid request body")
+		return
+	}
+
+	if err := h.requestEmailChangeUC.Execute(r.Context(), userID, sessionID, &req); err != nil {
+		switch err.Error() {
+		case "invalid current password":
+			sendErrorResponse(w, http.StatusUnauthorized, "INVALID_CREDENTIALS", "Current password is incorrect")
+		case "invalid email":
+			sendErrorResponse(w, http.StatusBadRequest, "INVALID_EMAIL", "Invalid email address")
+		case "email unchanged":
+			sendErrorResponse(w, http.StatusBadRequest, "EMAIL_UNCHANGED", "New email must be different from the current one")
+		case "email already registered":
+			sendErrorResponse(w, http.StatusBadRequest, "EMAIL_EXISTS", "Email already registered")
+		default:
+			sendErrorResponse(w, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to request email change")
+		}
+		return
+	}
+
+	sendSuccessResponse(w, http.StatusAccepted, "A confirmation link was sent to the new email address", nil)
+}
+
+// ConfirmEmailChange godoc
+// @Summary Confirm email change
+// @Description Apply an email change using the token sent to the new address
+// @Tags users
+// @Accept json
+// @Produce json
+// @Param request body dto.ConfirmEmailChangeRequest true "Email change token"
+// @Success 200 {object} dto.APIResponse "Email changed successfully"
+// @Failure 400 {object} dto.APIResponse "Invalid or expired token"
+// @Failure 500 {object} dto.APIResponse "Internal server error"
+// @Router /api/v1/auth/email/change/confirm [post]
+func (h *UserHandler) ConfirmEmailChange(w http.ResponseWriter, r *http.Request) {
+	var req dto.ConfirmEmailChangeRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		sendErrorResponse(w, http.StatusBadRequest, "INVALID_REQUEST", "Invalid request body")
+		return
+	}
+
+	if err := h.confirmEmailChangeUC.Execute(r.Context(), &req); err != nil {
+		if err.Error() == "invalid email change token" {
+			sendErrorResponse(w, http.StatusBadRequest, "INVALID_EMAIL_CHANGE_TOKEN", "Invalid or expired email change token")
+			return
+		}
+		if err.Error() == "email already registered" {
+			sendErrorResponse(w, http.StatusBadRequest, "EMAIL_EXISTS", "Email already registered")
+			return
+		}
+		sendErrorResponse(w, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to change email")
+		return
+	}
+
+	sendSuccessResponse(w, http.StatusOK, "Email changed successfully", nil)
+}
+
+// DeleteAccount godoc
+// @Summary Delete account
+// @Description Schedule the authenticated user's account for deletion and log out every session.
+// @Description The account and all its data are removed after a grace period; logging in again cancels the deletion.
+// @Tags users
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body dto.DeleteAccountRequest true "Current password"
+// @Success 202 {object} dto.APIResponse{data=dto.AccountDeletionResponse} "Account deletion scheduled"
+// @Failure 400 {object} dto.APIResponse "Invalid request body"
+// @Failure 401 {object} dto.APIResponse "User not authenticated or wrong password"
+// @Failure 500 {object} dto.APIResponse "Internal server error"
+// @Router /api/v1/users/me [delete]
+func (h *UserHandler) DeleteAccount(w http.ResponseWriter, r *http.Request) {
+	userID, ok := middleware.GetUserIDFromContext(r.Context())
+	if !ok {
+		sendErrorResponse(w, http.StatusUnauthorized, "UNAUTHORIZED", "User not authenticated")
+		return
+	}
+
+	var req dto.DeleteAccountRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		sendErrorResponse(w, http.StatusBadRequest, "INVALID_REQUEST", "Invalid request body")
+		return
+	}
+
+	result, err := h.deleteAccountUC.Execute(r.Context(), userID, &req)
+	if err != nil {
+		if err.Error() == "invalid current password" {
+			sendErrorResponse(w, http.StatusUnauthorized, "INVALID_CREDENTIALS", "Password is incorrect")
+			return
+		}
+		sendErrorResponse(w, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to delete account")
+		return
+	}
+
+	sendSuccessResponse(w, http.StatusAccepted, "Account deletion scheduled", result)
+}
+
+// ExportData godoc
+// @Summary Export personal data
+// @Description Download every record owned by the authenticated user (profile, sessions, watched list, favorites, ...)
+// @Description as a JSON file, or as a ZIP archive with one JSON file per table.
+// @Tags users
+// @Produce json
+// @Produce application/zip
+// @Security BearerAuth
+// @Param format query string false "json (default) or zip"
+// @Success 200 {object} dto.UserDataExport "Data export"
+// @Failure 400 {object} dto.APIResponse "Invalid format"
+// @Failure 401 {object} dto.APIResponse "User not authenticated"
+// @Failure 500 {object} dto.APIResponse "Internal server error"
+// @Router /api/v1/users/me/export [get]
+func (h *UserHandler) ExportData(w http.ResponseWriter, r *http.Request) {
+	userID, ok := middleware.GetUserIDFromContext(r.Context())
+	if !ok {
+		sendErrorResponse(w, http.StatusUnauthorized, "UNAUTHORIZED", "User not authenticated")
+		return
+	}
+
+	format := r.URL.Query().Get("format")
+	if format == "" {
+		format = "json"
+	}
+	if format != "json" && format != "zip" {
+		sendErrorResponse(w, http.StatusBadRequest, "INVALID_FORMAT", "Format must be json or zip")
+		return
+	}
+
+	export, err := h.exportUserDataUC.Execute(r.Context(), userID)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to export user data")
+		return
+	}
+
+	filename := fmt.Sprintf("cineverse-export-%s", export.ExportedAt.Format("20060102-150405"))
+	w.Header().Set("Cache-Control", "no-store")
+
+	if format == "json" {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="%s.json"`, filename))
+		w.WriteHeader(http.StatusOK)
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		encoder.Encode(export)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/zip")
+	w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="%s.zip"`, filename))
+	w.WriteHeader(http.StatusOK)
+	writeExportArchive(w, export)
+}
+
+func writeExportArchive(w http.ResponseWriter, export *dto.UserDataExport) {
+	archive := zip.NewWriter(w)
+	defer archive.Close()
+
+	tables := make([]string, 0, len(export.Data))
+	for table := range export.Data {
+		tables = append(tables, table)
+	}
+	sort.Strings(tables)
+
+	manifest := map[string]interface{}{
+		"user_id":     export.UserID,
+		"exported_at": export.ExportedAt,
+		"tables":      tables,
+	}
+	writeArchiveJSON(archive, "manifest.json", manifest)
+
+	for _, table := range tables {
+		writeArchiveJSON(archive, table+".json", export.Data[table])
+	}
+}
+
+func writeArchiveJSON(archive *zip.Writer, name string, value interface{}) {
+	file, err := archive.Create(name)
+	if err != nil {
+		return
+	}
+
+	encoder := json.NewEncoder(file)
+	encoder.SetIndent("", "  ")
+	encoder.Encode(value)
+}
@@ -2,9 +2,15 @@ package http
 
 import (
 	"encoding/json"
+	"errors"
+	"math"
+	"net"
 	"net/http"
+	"strconv"
 
 	"github.com/EduardoMG12/cine/api_v2/internal/dto"
+	"github.com/EduardoMG12/cine/api_v2/internal/infrastructure"
+	"github.com/EduardoMG12/cine/api_v2/internal/usecase/auth"
 )
 
 func sendSuccessResponse(w http.ResponseWriter, statusCode int, message string, data interface{}) {
@@ -32,3 +38,72 @@ func sendErrorResponse(w http.ResponseWriter, statusCode int, code, message stri
 	w.WriteHeader(statusCode)
 	json.NewEncoder(w).Encode(response)
 }
+
+// clientIP returns the IP address of the caller, or an empty string when it can't be parsed.
+// X-Forwarded-For / X-Real-IP from trusted proxies are already applied to RemoteAddr by the RealIP middleware.
+func clientIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		host = r.RemoteAddr
+	}
+
+	if net.ParseIP(host) == nil {
+		return ""
+	}
+	return host
+}
+
+func sessionMetadata(r *http.Request) auth.SessionMetadata {
+	userAgent := r.UserAgent()
+	if len(userAgent) > 512 {
+		userAgent = userAgent[:512]
+	}
+
+	return auth.SessionMetadata{
+		UserAgent: userAgent,
+		IPAddress: clientIP(r),
+	}
+}
+
+// sendTooManyAttemptsError answers 429 with a Retry-After header when err is a login lockout and reports whether it did
+func sendTooManyAttemptsError(w http.ResponseWriter, err error) bool {
+	var tooManyAttempts *infrastructure.TooManyAttemptsError
+	if !errors.As(err, &tooManyAttempts) {
+		return false
+	}
+
+	retryAfter := int(math.Ceil(tooManyAttempts.RetryAfter.Seconds()))
+	w.Header().Set("Retry-After", strconv.Itoa(retryAfter))
+	sendErrorResponse(w, http.StatusTooManyRequests, "TOO_MANY_ATTEMPTS", "Too many failed login attempts, try again later")
+	return true
+}
+
+// sendPasswordPolicyError answers 400 when err is a password policy violation and reports whether it did
+func sendPasswordPolicyError(w http.ResponseWriter, err error) bool {
+	switch err.Error() {
+	case "password too short":
+		sendErrorResponse(w, http.StatusBadRequest, "WEAK_PASSWORD", "Password must be at least 8 characters")
+	case "password too long":
+		sendErrorResponse(w, http.StatusBadRequest, "WEAK_PASSWORD", "Password must be at most 72 characters")
+	case "password too weak":
+		sendErrorResponse(w, http.StatusBadRequest, "WEAK_PASSWORD", "Password must contain at least one letter and one digit")
+	default:
+		return false
+	}
+	return true
+}
+
+// sendUsernameError answers 400 when err is a username rule violation and reports whether it did
+func sendUsernameError(w http.ResponseWriter, err error) bool {
+	switch err.Error() {
+	case "invalid username":
+		sendErrorResponse(w, http.StatusBadRequest, "INVALID_USERNAME", "Username must be 3-30 lowercase letters, digits, '_' or '.', starting with a letter or digit")
+	case "username reserved":
+		sendErrorResponse(w, http.StatusBadRequest, "USERNAME_RESERVED", "Username is reserved")
+	case "username already taken":
+		sendErrorResponse(w, http.StatusBadRequest, "USERNAME_EXISTS", "Username already taken")
+	default:
+		return false
+	}
+	return true
+}
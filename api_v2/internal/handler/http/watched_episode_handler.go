@@ -0,0 +1,109 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/EduardoMG12/cine/api_v2/internal/dto"
+	"github.com/EduardoMG12/cine/api_v2/internal/middleware"
+	"github.com/EduardoMG12/cine/api_v2/internal/usecase/user_movie"
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+)
+
+type WatchedEpisodeHandler struct {
+	toggleWatchedEpisodeUC *user_movie.ToggleWatchedEpisodeUseCase
+	getSeriesProgressUC    *user_movie.GetSeriesProgressUseCase
+}
+
+func NewWatchedEpisodeHandler(
+	toggleWatchedEpisodeUC *user_movie.ToggleWatchedEpisodeUseCase,
+	getSeriesProgressUC *user_movie.GetSeriesProgressUseCase,
+) *WatchedEpisodeHandler {
+	return &WatchedEpisodeHandler{
+		toggleWatchedEpisodeUC: toggleWatchedEpisodeUC,
+		getSeriesProgressUC:    getSeriesProgressUC,
+	}
+}
+
+// ToggleWatchedEpisode godoc
+// @Summary Toggle episode in watched episodes
+// @Description Mark an episode as watched by the authenticated user, or unmark it if it already was
+// @Tags user-movies
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body dto.ToggleWatchedEpisodeRequest true "Episode ID to toggle in watched episodes"
+// @Success 200 {object} dto.APIResponse{data=dto.ToggleResponse} "Episode toggled in watched episodes successfully"
+// @Failure 400 {object} dto.APIResponse "Invalid request body"
+// @Failure 401 {object} dto.APIResponse "User not authenticated"
+// @Failure 404 {object} dto.APIResponse "Episode not found"
+// @Failure 500 {object} dto.APIResponse "Internal server error"
+// @Router /api/v1/watched/episodes [post]
+func (h *WatchedEpisodeHandler) ToggleWatchedEpisode(w http.ResponseWriter, r *http.Request) {
+	userID, ok := middleware.GetUserIDFromContext(r.Context())
+	if !ok {
+		sendErrorResponse(w, http.StatusUnauthorized, "UNAUTHORIZED", "User not authenticated")
+		return
+	}
+
+	var req dto.ToggleWatchedEpisodeRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		sendErrorResponse(w, http.StatusBadRequest, "INVALID_REQUEST", "Invalid request body")
+		return
+	}
+
+	result, err := h.toggleWatchedEpisodeUC.Execute(r.Context(), userID, req.EpisodeID)
+	if err != nil {
+		if err.Error() == "episode not found" {
+			sendErrorResponse(w, http.StatusNotFound, "EPISODE_NOT_FOUND", err.Error())
+			return
+		}
+		sendErrorResponse(w, http.StatusInternalServerError, "INTERNAL_ERROR", err.Error())
+		return
+	}
+
+	sendSuccessResponse(w, http.StatusOK, result.Message, result)
+}
+
+// GetSeriesProgress godoc
+// @Summary Get user's progress in a series
+// @Description Get the episodes of a series the authenticated user has watched and the next one to watch, over the synced seasons
+// @Tags user-movies
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Series ID"
+// @Success 200 {object} dto.APIResponse{data=dto.SeriesProgressDTO} "Series progress retrieved successfully"
+// @Failure 400 {object} dto.APIResponse "Invalid series ID"
+// @Failure 401 {object} dto.APIResponse "User not authenticated"
+// @Failure 404 {object} dto.APIResponse "Series not found"
+// @Failure 500 {object} dto.APIResponse "Internal server error"
+// @Router /api/v1/watched/series/{id} [get]
+func (h *WatchedEpisodeHandler) GetSeriesProgress(w http.ResponseWriter, r *http.Request) {
+	userID, ok := middleware.GetUserIDFromContext(r.Context())
+	if !ok {
+		sendErrorResponse(w, http.StatusUnauthorized, "UNAUTHORIZED", "User not authenticated")
+		return
+	}
+
+	seriesID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		sendErrorResponse(w, http.StatusBadRequest, "INVALID_ID", "Invalid series ID")
+		return
+	}
+
+	result, err := h.getSeriesProgressUC.Execute(r.Context(), userID, seriesID)
+	if err != nil {
+		switch err.Error() {
+		case "series not found":
+			sendErrorResponse(w, http.StatusNotFound, "SERIES_NOT_FOUND", "Series not found")
+		case "not a series":
+			sendErrorResponse(w, http.StatusBadRequest, "NOT_A_SERIES", "Title is not a series")
+		default:
+			sendErrorResponse(w, http.StatusInternalServerError, "INTERNAL_ERROR", err.Error())
+		}
+		return
+	}
+
+	sendSuccessResponse(w, http.StatusOK, "Series progress retrieved successfully", result)
+}
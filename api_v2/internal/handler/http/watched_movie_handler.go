@@ -51,7 +51,7 @@ func (h *WatchedMovieHandler) ToggleWatchedMovie(w http.ResponseWriter, r *http.
 		return
 	}
 
-	result, err := h.toggleWatchedUC.Execute(userID, req.MovieID)
+	result, err := h.toggleWatchedUC.Execute(r.Context(), userID, req.MovieID)
 	if err != nil {
 		if err.Error() == "movie not found" {
 			sendErrorResponse(w, http.StatusNotFound, "MOVIE_NOT_FOUND", err.Error())
@@ -81,7 +81,7 @@ func (h *WatchedMovieHandler) GetWatchedMovies(w http.ResponseWriter, r *http.Re
 		return
 	}
 
-	movies, err := h.getWatchedUC.Execute(userID)
+	movies, err := h.getWatchedUC.Execute(r.Context(), userID)
 	if err != nil {
 		sendErrorResponse(w, http.StatusInternalServerError, "INTERNAL_ERROR", err.Error())
 		return
@@ -0,0 +1,255 @@
+package infrastructure
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/EduardoMG12/cine/api_v2/internal/domain"
+)
+
+const (
+	CircuitClosed   = "closed"
+	CircuitOpen     = "open"
+	CircuitHalfOpen = "half_open"
+
+	// Error rate and latency are computed over the most recent calls
+	circuitStatsWindow = 100
+)
+
+type callOutcome struct {
+	latency time.Duration
+	failed  bool
+}
+
+// CircuitBreakerStats is a snapshot of a provider's health
+type CircuitBreakerStats struct {
+	Provider            string
+	State               string
+	ConsecutiveFailures int
+	Calls               int
+	Failures            int
+	ErrorRate           float64
+	AverageLatency      time.Duration
+	OpenedAt            *time.Time
+	LastError           string
+	LastErrorAt         *time.Time
+}
+
+// CircuitBreaker stops calling a provider after failureThreshold consecutive failures.
+// Once openDuration has passed a single probe call is let through (half-open):
+// success closes the circuit again, failure keeps it open for another openDuration.
+type CircuitBreaker struct {
+	provider         string
+	failureThreshold int
+	openDuration     time.Duration
+
+	mu                  sync.Mutex
+	state               string
+	consecutiveFailures int
+	openedAt            time.Time
+	probing             bool
+	outcomes            []callOutcome
+	nextOutcome         int
+	lastError           string
+	lastErrorAt         time.Time
+}
+
+func NewCircuitBreaker(provider string, failureThreshold int, openDuration time.Duration) *CircuitBreaker {
+	if failureThreshold < 1 {
+		failureThreshold = 1
+	}
+
+	return &CircuitBreaker{
+		provider:         provider,
+		failureThreshold: failureThreshold,
+		openDuration:     openDuration,
+		state:            CircuitClosed,
+		outcomes:         make([]callOutcome, 0, circuitStatsWindow),
+	}
+}
+
+// Provider returns the name of the provider guarded by the breaker
+func (b *CircuitBreaker) Provider() string {
+	return b.provider
+}
+
+// Allow reports whether a call may be made now
+func (b *CircuitBreaker) Allow() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	switch b.state {
+	case CircuitOpen:
+		if time.Since(b.openedAt) < b.openDuration {
+			return false
+		}
+		b.state = CircuitHalfOpen
+		b.probing = true
+		log.Printf("[CircuitBreaker] %s half-open, probing provider", b.provider)
+		return true
+	case CircuitHalfOpen:
+		if b.probing {
+			return false
+		}
+		b.probing = true
+		return true
+	default:
+		return true
+	}
+}
+
+// Record stores the outcome of a call allowed by Allow. Only errors wrapping
+// ErrProviderUnavailable count as failures; "not found" answers mean the provider is healthy,
+// and an exhausted quota says nothing about the provider's health.
+func (b *CircuitBreaker) Record(latency time.Duration, err error) {
+	failed := err != nil && errors.Is(err, ErrProviderUnavailable) && !errors.Is(err, ErrQuotaExhausted)
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	outcome := callOutcome{latency: latency, failed: failed}
+	if len(b.outcomes) < circuitStatsWindow {
+		b.outcomes = append(b.outcomes, outcome)
+	} else {
+		b.outcomes[b.nextOutcome] = outcome
+	}
+	b.nextOutcome = (b.nextOutcome + 1) % circuitStatsWindow
+	b.probing = false
+
+	if !failed {
+		b.consecutiveFailures = 0
+		if b.state != CircuitClosed {
+			log.Printf("[CircuitBreaker] %s recovered, circuit closed", b.provider)
+			b.state = CircuitClosed
+		}
+		return
+	}
+
+	b.consecutiveFailures++
+	b.lastError = err.Error()
+	b.lastErrorAt = time.Now()
+
+	if b.state == CircuitHalfOpen || b.consecutiveFailures >= b.failureThreshold {
+		if b.state != CircuitOpen {
+			log.Printf("[CircuitBreaker] %s failing (%d consecutive failures), circuit open for %s",
+				b.provider, b.consecutiveFailures, b.openDuration)
+		}
+		b.state = CircuitOpen
+		b.openedAt = time.Now()
+	}
+}
+
+// Stats returns the current state, error rate and latency of the provider
+func (b *CircuitBreaker) Stats() CircuitBreakerStats {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	stats := CircuitBreakerStats{
+		Provider:            b.provider,
+		State:               b.state,
+		ConsecutiveFailures: b.consecutiveFailures,
+		Calls:               len(b.outcomes),
+		LastError:           b.lastError,
+	}
+
+	var totalLatency time.Duration
+	for _, outcome := range b.outcomes {
+		totalLatency += outcome.latency
+		if outcome.failed {
+			stats.Failures++
+		}
+	}
+	if stats.Calls > 0 {
+		stats.ErrorRate = float64(stats.Failures) / float64(stats.Calls)
+		stats.AverageLatency = totalLatency / time.Duration(stats.Calls)
+	}
+
+	if b.state != CircuitClosed {
+		openedAt := b.openedAt
+		stats.OpenedAt = &openedAt
+	}
+	if !b.lastErrorAt.IsZero() {
+		lastErrorAt := b.lastErrorAt
+		stats.LastErrorAt = &lastErrorAt
+	}
+
+	return stats
+}
+
+// CircuitBreakerFetcher guards a provider link of the chain with a circuit breaker.
+// The wrapped fetcher must not have a next link of its own: the breaker needs to see
+// its failures before handing the operation to the rest of the chain.
+type CircuitBreakerFetcher struct {
+	BaseMovieFetcher
+	fetcher MovieFetcher
+	breaker *CircuitBreaker
+}
+
+func NewCircuitBreakerFetcher(fetcher MovieFetcher, breaker *CircuitBreaker) *CircuitBreakerFetcher {
+	return &CircuitBreakerFetcher{
+		fetcher: fetcher,
+		breaker: breaker,
+	}
+}
+
+func (c *CircuitBreakerFetcher) GetProviderName() string {
+	return c.fetcher.GetProviderName()
+}
+
+func (c *CircuitBreakerFetcher) FetchByExternalID(ctx context.Context, externalID string) (*domain.Movie, error) {
+	if err := c.allow(); err != nil {
+		return c.tryNext(ctx, err, "fetchByExternalID", externalID)
+	}
+
+	start := time.Now()
+	movie, err := c.fetcher.FetchByExternalID(ctx, externalID)
+	c.breaker.Record(time.Since(start), err)
+	if err != nil {
+		return c.tryNext(ctx, err, "fetchByExternalID", externalID)
+	}
+
+	return movie, nil
+}
+
+func (c *CircuitBreakerFetcher) FetchByTitle(ctx context.Context, title string, year string) (*domain.Movie, error) {
+	if err := c.allow(); err != nil {
+		return c.tryNext(ctx, err, "fetchByTitle", title, year)
+	}
+
+	start := time.Now()
+	movie, err := c.fetcher.FetchByTitle(ctx, title, year)
+	c.breaker.Record(time.Since(start), err)
+	if err != nil {
+		return c.tryNext(ctx, err, "fetchByTitle", title, year)
+	}
+
+	return movie, nil
+}
+
+func (c *CircuitBreakerFetcher) Search(ctx context.Context, query string, page int) ([]*domain.Movie, error) {
+	if err := c.allow(); err != nil {
+		return c.tryNextSearch(ctx, err, query, page)
+	}
+
+	start := time.Now()
+	movies, err := c.fetcher.Search(ctx, query, page)
+	c.breaker.Record(time.Since(start), err)
+	if err != nil {
+		return c.tryNextSearch(ctx, err, query, page)
+	}
+
+	return movies, nil
+}
+
+func (c *CircuitBreakerFetcher) allow() error {
+	if c.breaker.Allow() {
+		return nil
+	}
+
+	log.Printf("[MovieFetcher] %s circuit open, skipping provider", c.breaker.Provider())
+	return fmt.Errorf("%w: %s circuit open", ErrProviderUnavailable, c.breaker.Provider())
+}
@@ -0,0 +1,94 @@
+package infrastructure
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// DistributedLock lets a single instance run a job at a time. The lock lives in Redis with a TTL,
+// so it is freed if its holder dies; holders refresh it while they work. Without Redis the lock
+// only covers this instance.
+type DistributedLock struct {
+	redis *RedisService
+	key   string
+	ttl   time.Duration
+
+	mu    sync.Mutex
+	token string
+}
+
+func NewDistributedLock(redis *RedisService, key string, ttl time.Duration) *DistributedLock {
+	return &DistributedLock{
+		redis: redis,
+		key:   key,
+		ttl:   ttl,
+	}
+}
+
+// TTL returns how long the lock lives without a refresh
+func (l *DistributedLock) TTL() time.Duration {
+	return l.ttl
+}
+
+// TryAcquire takes the lock if nobody holds it
+func (l *DistributedLock) TryAcquire(ctx context.Context) (bool, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.token != "" {
+		return false, nil
+	}
+
+	token := uuid.NewString()
+	if l.redis != nil {
+		acquired, err := l.redis.AcquireLock(ctx, l.key, token, l.ttl)
+		if err != nil || !acquired {
+			return false, err
+		}
+	}
+
+	l.token = token
+	return true, nil
+}
+
+// Refresh extends the lock; false means it was lost (e.g. it expired and another instance took it)
+func (l *DistributedLock) Refresh(ctx context.Context) (bool, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.token == "" {
+		return false, nil
+	}
+	if l.redis == nil {
+		return true, nil
+	}
+
+	held, err := l.redis.RefreshLock(ctx, l.key, l.token, l.ttl)
+	if err != nil {
+		return false, err
+	}
+	if !held {
+		l.token = ""
+	}
+	return held, nil
+}
+
+// Release frees the lock if this instance still holds it
+func (l *DistributedLock) Release(ctx context.Context) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.token == "" {
+		return nil
+	}
+
+	token := l.token
+	l.token = ""
+	if l.redis == nil {
+		return nil
+	}
+	return l.redis.ReleaseLock(ctx, l.key, token)
+}
@@ -4,48 +4,91 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/EduardoMG12/cine/api_v2/internal/domain"
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/google/uuid"
 )
 
 type JWTService struct {
-	secret string
+	keys           *JWTKeyManager
+	expirationTime time.Duration
+	issuer         string
 }
 
-func NewJWTService(secret string) *JWTService {
-	return &JWTService{secret: secret}
+func NewJWTService(keys *JWTKeyManager, expirationTime time.Duration, issuer string) *JWTService {
+	return &JWTService{
+		keys:           keys,
+		expirationTime: expirationTime,
+		issuer:         issuer,
+	}
+}
+
+// ExpirationTime returns how long generated access tokens stay valid
+func (s *JWTService) ExpirationTime() time.Duration {
+	return s.expirationTime
 }
 
-func (s *JWTService) GenerateToken(userID uuid.UUID) (string, error) {
-	claims := jwt.MapClaims{
-		"user_id": userID.String(),
-		"exp":     time.Now().Add(24 * time.Hour).Unix(),
-		"iat":     time.Now().Unix(),
+func (s *JWTService) GenerateToken(user *domain.User) (string, error) {
+	key := s.keys.signingKey()
+	if key == nil {
+		return "", fmt.Errorf("no signing key available")
+	}
+
+	now := time.Now()
+	claims := domain.JWTClaims{
+		UserID:   user.ID,
+		Username: user.Username,
+		Email:    user.Email,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        uuid.New().String(),
+			Issuer:    s.issuer,
+			Subject:   user.ID.String(),
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(s.expirationTime)),
+		},
 	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	return token.SignedString([]byte(s.secret))
+	token := jwt.NewWithClaims(key.method, claims)
+	token.Header["kid"] = key.id
+	return token.SignedString(key.private)
+}
+
+// ValidateToken verifies the signature, issuer and expiration of a token
+func (s *JWTService) ValidateToken(tokenString string) (*domain.JWTClaims, error) {
+	return s.parse(tokenString,
+		jwt.WithIssuer(s.issuer),
+		jwt.WithExpirationRequired(),
+	)
 }
 
-func (s *JWTService) ValidateToken(tokenString string) (uuid.UUID, error) {
-	token, err := jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
-		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+func (s *JWTService) parse(tokenString string, opts ...jwt.ParserOption) (*domain.JWTClaims, error) {
+	opts = append(opts, jwt.WithValidMethods([]string{SigningAlgorithmRS256, SigningAlgorithmEdDSA}))
+
+	claims := &domain.JWTClaims{}
+	token, err := jwt.ParseWithClaims(tokenString, claims, func(token *jwt.Token) (interface{}, error) {
+		kid, ok := token.Header["kid"].(string)
+		if !ok {
+			return nil, fmt.Errorf("missing kid header")
+		}
+
+		key, ok := s.keys.verificationKey(kid)
+		if !ok {
+			return nil, fmt.Errorf("unknown signing key: %s", kid)
+		}
+		if token.Method.Alg() != key.method.Alg() {
 			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
 		}
-		return []byte(s.secret), nil
-	})
+
+		return key.private.Public(), nil
+	}, opts...)
 
 	if err != nil {
-		return uuid.Nil, err
+		return nil, err
 	}
 
-	if claims, ok := token.Claims.(jwt.MapClaims); ok && token.Valid {
-		userIDStr, ok := claims["user_id"].(string)
-		if !ok {
-			return uuid.Nil, fmt.Errorf("invalid user_id claim")
-		}
-		return uuid.Parse(userIDStr)
+	if !token.Valid || claims.UserID == uuid.Nil {
+		return nil, fmt.Errorf("invalid token")
 	}
 
-	return uuid.Nil, fmt.Errorf("invalid token")
+	return claims, nil
 }
@@ -0,0 +1,355 @@
+package infrastructure
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/pem"
+	"fmt"
+	"log"
+	"math/big"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const (
+	SigningAlgorithmRS256 = "RS256"
+	SigningAlgorithmEdDSA = "EdDSA"
+
+	rsaKeyBits         = 2048
+	jwtKeyFileExt      = ".pem"
+	jwtKeyPollInterval = time.Minute
+)
+
+// JSONWebKey is the public part of a signing key as published in the JWKS document
+type JSONWebKey struct {
+	KeyType   string `json:"kty"`
+	KeyID     string `json:"kid"`
+	Use       string `json:"use"`
+	Algorithm string `json:"alg"`
+	N         string `json:"n,omitempty"`
+	E         string `json:"e,omitempty"`
+	Curve     string `json:"crv,omitempty"`
+	X         string `json:"x,omitempty"`
+}
+
+// JSONWebKeySet is the document served at /.well-known/jwks.json
+type JSONWebKeySet struct {
+	Keys []JSONWebKey `json:"keys"`
+}
+
+type signingKey struct {
+	id        string
+	method    jwt.SigningMethod
+	private   crypto.Signer
+	createdAt time.Time
+}
+
+// JWTKeyManager keeps the asymmetric keys used to sign access tokens.
+// Keys are PKCS#8 PEM files in a directory, the file name (without extension) is the kid
+// and the file modification time is the creation time. The newest key signs new tokens,
+// older keys keep verifying tokens until the grace period after they were superseded ends.
+type JWTKeyManager struct {
+	dir              string
+	algorithm        string
+	rotationInterval time.Duration
+	gracePeriod      time.Duration
+
+	mu   sync.RWMutex
+	keys []*signingKey // newest first
+
+	stop chan struct{}
+	done chan struct{}
+}
+
+func NewJWTKeyManager(dir, algorithm string, rotationInterval, gracePeriod time.Duration) (*JWTKeyManager, error) {
+	if _, err := signingMethodFor(algorithm); err != nil {
+		return nil, err
+	}
+
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, fmt.Errorf("failed to create JWT key directory: %w", err)
+	}
+
+	m := &JWTKeyManager{
+		dir:              dir,
+		algorithm:        algorithm,
+		rotationInterval: rotationInterval,
+		gracePeriod:      gracePeriod,
+	}
+
+	if err := m.refresh(); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// Start periodically reloads the key directory and rotates the signing key when it is due.
+// Reloading also picks up keys rotated by other instances sharing the directory.
+func (m *JWTKeyManager) Start() {
+	if m.stop != nil {
+		return
+	}
+
+	m.stop = make(chan struct{})
+	m.done = make(chan struct{})
+
+	go func() {
+		defer close(m.done)
+
+		ticker := time.NewTicker(jwtKeyPollInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				if err := m.refresh(); err != nil {
+					log.Printf("[JWTKeys] Failed to refresh signing keys: %v", err)
+				}
+			case <-m.stop:
+				return
+			}
+		}
+	}()
+}
+
+// Stop ends the rotation loop started by Start
+func (m *JWTKeyManager) Stop() {
+	if m.stop == nil {
+		return
+	}
+
+	close(m.stop)
+	<-m.done
+	m.stop = nil
+}
+
+func (m *JWTKeyManager) refresh() error {
+	keys, err := m.loadKeys()
+	if err != nil {
+		return err
+	}
+
+	if len(keys) == 0 || m.rotationDue(keys[0]) {
+		key, err := m.generateKey()
+		if err != nil {
+			return err
+		}
+		log.Printf("[JWTKeys] Generated new %s signing key %s", m.algorithm, key.id)
+		keys = append([]*signingKey{key}, keys...)
+	}
+
+	keys = m.pruneExpired(keys)
+
+	m.mu.Lock()
+	m.keys = keys
+	m.mu.Unlock()
+
+	return nil
+}
+
+func (m *JWTKeyManager) rotationDue(newest *signingKey) bool {
+	if newest.method.Alg() != m.algorithm {
+		return true
+	}
+	return m.rotationInterval > 0 && time.Since(newest.createdAt) >= m.rotationInterval
+}
+
+// pruneExpired drops keys whose grace period ended and removes their files
+func (m *JWTKeyManager) pruneExpired(keys []*signingKey) []*signingKey {
+	kept := keys[:1]
+	for i := 1; i < len(keys); i++ {
+		supersededAt := keys[i-1].createdAt
+		if time.Since(supersededAt) < m.gracePeriod {
+			kept = append(kept, keys[i])
+			continue
+		}
+
+		log.Printf("[JWTKeys] Retiring signing key %s", keys[i].id)
+		if err := os.Remove(m.keyPath(keys[i].id)); err != nil && !os.IsNotExist(err) {
+			log.Printf("[JWTKeys] Failed to remove key file %s: %v", keys[i].id, err)
+		}
+	}
+	return kept
+}
+
+func (m *JWTKeyManager) loadKeys() ([]*signingKey, error) {
+	entries, err := os.ReadDir(m.dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read JWT key directory: %w", err)
+	}
+
+	keys := make([]*signingKey, 0, len(entries))
+	for _, entry := range entries {
+		if entry.IsDir() || filepath.Ext(entry.Name()) != jwtKeyFileExt {
+			continue
+		}
+
+		info, err := entry.Info()
+		if err != nil {
+			return nil, fmt.Errorf("failed to stat JWT key %s: %w", entry.Name(), err)
+		}
+
+		key, err := readSigningKey(filepath.Join(m.dir, entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+		key.id = strings.TrimSuffix(entry.Name(), jwtKeyFileExt)
+		key.createdAt = info.ModTime()
+
+		keys = append(keys, key)
+	}
+
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i].createdAt.After(keys[j].createdAt)
+	})
+
+	return keys, nil
+}
+
+func (m *JWTKeyManager) generateKey() (*signingKey, error) {
+	var private crypto.Signer
+	var err error
+
+	switch m.algorithm {
+	case SigningAlgorithmRS256:
+		private, err = rsa.GenerateKey(rand.Reader, rsaKeyBits)
+	case SigningAlgorithmEdDSA:
+		_, private, err = ed25519.GenerateKey(rand.Reader)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate signing key: %w", err)
+	}
+
+	der, err := x509.MarshalPKCS8PrivateKey(private)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode signing key: %w", err)
+	}
+
+	suffix := make([]byte, 4)
+	if _, err := rand.Read(suffix); err != nil {
+		return nil, fmt.Errorf("failed to generate key id: %w", err)
+	}
+
+	now := time.Now()
+	id := fmt.Sprintf("%s-%s", now.UTC().Format("20060102T150405"), hex.EncodeToString(suffix))
+
+	data := pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})
+	if err := os.WriteFile(m.keyPath(id), data, 0o600); err != nil {
+		return nil, fmt.Errorf("failed to write signing key: %w", err)
+	}
+
+	method, _ := signingMethodFor(m.algorithm)
+	return &signingKey{
+		id:        id,
+		method:    method,
+		private:   private,
+		createdAt: now,
+	}, nil
+}
+
+func (m *JWTKeyManager) keyPath(id string) string {
+	return filepath.Join(m.dir, id+jwtKeyFileExt)
+}
+
+// signingKey returns the key new tokens are signed with
+func (m *JWTKeyManager) signingKey() *signingKey {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	if len(m.keys) == 0 {
+		return nil
+	}
+	return m.keys[0]
+}
+
+// verificationKey returns the key identified by kid, if it is still trusted
+func (m *JWTKeyManager) verificationKey(kid string) (*signingKey, bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	for _, key := range m.keys {
+		if key.id == kid {
+			return key, true
+		}
+	}
+	return nil, false
+}
+
+// JWKS returns the public keys that currently verify tokens
+func (m *JWTKeyManager) JWKS() JSONWebKeySet {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	set := JSONWebKeySet{Keys: make([]JSONWebKey, 0, len(m.keys))}
+	for _, key := range m.keys {
+		jwk := JSONWebKey{
+			KeyID:     key.id,
+			Use:       "sig",
+			Algorithm: key.method.Alg(),
+		}
+
+		switch public := key.private.Public().(type) {
+		case *rsa.PublicKey:
+			jwk.KeyType = "RSA"
+			jwk.N = base64.RawURLEncoding.EncodeToString(public.N.Bytes())
+			jwk.E = base64.RawURLEncoding.EncodeToString(big.NewInt(int64(public.E)).Bytes())
+		case ed25519.PublicKey:
+			jwk.KeyType = "OKP"
+			jwk.Curve = "Ed25519"
+			jwk.X = base64.RawURLEncoding.EncodeToString(public)
+		}
+
+		set.Keys = append(set.Keys, jwk)
+	}
+
+	return set
+}
+
+func readSigningKey(path string) (*signingKey, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read JWT key %s: %w", path, err)
+	}
+
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("invalid PEM data in JWT key %s", path)
+	}
+
+	parsed, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse JWT key %s: %w", path, err)
+	}
+
+	switch private := parsed.(type) {
+	case *rsa.PrivateKey:
+		return &signingKey{method: jwt.SigningMethodRS256, private: private}, nil
+	case ed25519.PrivateKey:
+		return &signingKey{method: jwt.SigningMethodEdDSA, private: private}, nil
+	default:
+		return nil, fmt.Errorf("unsupported key type in JWT key %s", path)
+	}
+}
+
+func signingMethodFor(algorithm string) (jwt.SigningMethod, error) {
+	switch algorithm {
+	case SigningAlgorithmRS256:
+		return jwt.SigningMethodRS256, nil
+	case SigningAlgorithmEdDSA:
+		return jwt.SigningMethodEdDSA, nil
+	default:
+		return nil, fmt.Errorf("unsupported JWT signing algorithm: %s", algorithm)
+	}
+}
@@ -0,0 +1,296 @@
+package infrastructure
+
+import (
+	"context"
+	"log"
+	"math"
+	"net"
+	"sync"
+	"time"
+)
+
+const (
+	loginFailuresKeyPrefix = "login:failures:"
+	loginLockKeyPrefix     = "login:lock:"
+
+	LockoutScopeEmail = "email"
+	LockoutScopeIP    = "ip"
+)
+
+// TooManyAttemptsError is returned while an email or IP address is locked out
+type TooManyAttemptsError struct {
+	RetryAfter time.Duration
+}
+
+func (e *TooManyAttemptsError) Error() string {
+	return "too many attempts"
+}
+
+// Lockout describes a lock started by a failed login
+type Lockout struct {
+	Scope       string
+	Identifier  string
+	Failures    int64
+	LockedUntil time.Time
+}
+
+// attemptStore keeps failure counters and locks
+type attemptStore interface {
+	Increment(ctx context.Context, key string, window time.Duration) (int64, error)
+	Lock(ctx context.Context, key string, duration time.Duration) error
+	LockedFor(ctx context.Context, key string) (time.Duration, error)
+	Reset(ctx context.Context, key string) error
+}
+
+// LoginThrottler counts failed logins per email and per IP address.
+// Once a limit is reached every further failure locks the identifier for an
+// exponentially growing duration. Counters live in Redis when available so they
+// are shared between instances, in memory otherwise.
+type LoginThrottler struct {
+	store            attemptStore
+	maxEmailFailures int64
+	maxIPFailures    int64
+	window           time.Duration
+	baseLockout      time.Duration
+	maxLockout       time.Duration
+}
+
+func NewLoginThrottler(
+	redis *RedisService,
+	maxEmailFailures, maxIPFailures int,
+	window, baseLockout, maxLockout time.Duration,
+) *LoginThrottler {
+	var store attemptStore
+	if redis != nil {
+		store = &redisAttemptStore{redis: redis}
+	} else {
+		store = newMemoryAttemptStore()
+	}
+
+	return &LoginThrottler{
+		store:            store,
+		maxEmailFailures: int64(maxEmailFailures),
+		maxIPFailures:    int64(maxIPFailures),
+		window:           window,
+		baseLockout:      baseLockout,
+		maxLockout:       maxLockout,
+	}
+}
+
+// Check returns a *TooManyAttemptsError if the email or the IP address is locked out.
+// Store failures are logged and never block a login.
+func (t *LoginThrottler) Check(ctx context.Context, email, ip string) error {
+	var retryAfter time.Duration
+
+	for _, key := range t.keys(email, ip) {
+		lockedFor, err := t.store.LockedFor(ctx, loginLockKeyPrefix+key)
+		if err != nil {
+			log.Printf("[LoginThrottler] Failed to check lock: %v", err)
+			continue
+		}
+		if lockedFor > retryAfter {
+			retryAfter = lockedFor
+		}
+	}
+
+	if retryAfter > 0 {
+		return &TooManyAttemptsError{RetryAfter: retryAfter}
+	}
+	return nil
+}
+
+// RegisterFailure counts a failed login and returns the lockouts it started
+func (t *LoginThrottler) RegisterFailure(ctx context.Context, email, ip string) []Lockout {
+	var lockouts []Lockout
+	ip = throttledIP(ip)
+
+	limits := []struct {
+		scope      string
+		identifier string
+		max        int64
+	}{
+		{LockoutScopeEmail, email, t.maxEmailFailures},
+		{LockoutScopeIP, ip, t.maxIPFailures},
+	}
+
+	for _, limit := range limits {
+		if limit.identifier == "" {
+			continue
+		}
+
+		key := limit.scope + ":" + limit.identifier
+		// The counter outlives the longest lock so backoff keeps growing across lockouts
+		failures, err := t.store.Increment(ctx, loginFailuresKeyPrefix+key, t.window+t.maxLockout)
+		if err != nil {
+			log.Printf("[LoginThrottler] Failed to count login failure: %v", err)
+			continue
+		}
+		if failures < limit.max {
+			continue
+		}
+
+		duration := t.lockoutDuration(failures - limit.max)
+		if err := t.store.Lock(ctx, loginLockKeyPrefix+key, duration); err != nil {
+			log.Printf("[LoginThrottler] Failed to lock %s: %v", limit.scope, err)
+			continue
+		}
+
+		lockouts = append(lockouts, Lockout{
+			Scope:       limit.scope,
+			Identifier:  limit.identifier,
+			Failures:    failures,
+			LockedUntil: time.Now().Add(duration),
+		})
+	}
+
+	return lockouts
+}
+
+// RegisterSuccess clears the failures of an email. IP counters are kept since
+// a successful login on one account says nothing about the other attempts from that address.
+func (t *LoginThrottler) RegisterSuccess(ctx context.Context, email string) {
+	key := LockoutScopeEmail + ":" + email
+	if err := t.store.Reset(ctx, loginFailuresKeyPrefix+key); err != nil {
+		log.Printf("[LoginThrottler] Failed to reset login failures: %v", err)
+	}
+}
+
+func (t *LoginThrottler) lockoutDuration(excess int64) time.Duration {
+	if excess > 30 {
+		return t.maxLockout
+	}
+
+	duration := time.Duration(float64(t.baseLockout) * math.Pow(2, float64(excess)))
+	if duration > t.maxLockout {
+		return t.maxLockout
+	}
+	return duration
+}
+
+func (t *LoginThrottler) keys(email, ip string) []string {
+	keys := make([]string, 0, 2)
+	if email != "" {
+		keys = append(keys, LockoutScopeEmail+":"+email)
+	}
+	if ip = throttledIP(ip); ip != "" {
+		keys = append(keys, LockoutScopeIP+":"+ip)
+	}
+	return keys
+}
+
+// throttledIP is the address failures are counted on. The ip must be the connection address
+// (or the one given by a trusted proxy), never a client supplied header. IPv6 clients usually
+// get a whole /64, so its addresses are counted together.
+func throttledIP(ip string) string {
+	parsed := net.ParseIP(ip)
+	if parsed == nil {
+		return ""
+	}
+	if parsed.To4() != nil {
+		return parsed.String()
+	}
+	return (&net.IPNet{IP: parsed.Mask(net.CIDRMask(64, 128)), Mask: net.CIDRMask(64, 128)}).String()
+}
+
+type redisAttemptStore struct {
+	redis *RedisService
+}
+
+func (s *redisAttemptStore) Increment(ctx context.Context, key string, window time.Duration) (int64, error) {
+	return s.redis.Increment(ctx, key, window)
+}
+
+func (s *redisAttemptStore) Lock(ctx context.Context, key string, duration time.Duration) error {
+	return s.redis.Set(ctx, key, true, duration)
+}
+
+func (s *redisAttemptStore) LockedFor(ctx context.Context, key string) (time.Duration, error) {
+	return s.redis.TTL(ctx, key)
+}
+
+func (s *redisAttemptStore) Reset(ctx context.Context, key string) error {
+	return s.redis.Delete(ctx, key)
+}
+
+type memoryEntry struct {
+	value     int64
+	expiresAt time.Time
+}
+
+// memoryAttemptStore is the single-instance fallback used when Redis is unavailable
+type memoryAttemptStore struct {
+	mu        sync.Mutex
+	entries   map[string]memoryEntry
+	lastSweep time.Time
+}
+
+func newMemoryAttemptStore() *memoryAttemptStore {
+	return &memoryAttemptStore{
+		entries:   make(map[string]memoryEntry),
+		lastSweep: time.Now(),
+	}
+}
+
+func (s *memoryAttemptStore) Increment(ctx context.Context, key string, window time.Duration) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	s.sweep(now)
+
+	entry := s.entries[key]
+	if now.After(entry.expiresAt) {
+		entry.value = 0
+	}
+	entry.value++
+	entry.expiresAt = now.Add(window)
+	s.entries[key] = entry
+
+	return entry.value, nil
+}
+
+func (s *memoryAttemptStore) Lock(ctx context.Context, key string, duration time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.entries[key] = memoryEntry{value: 1, expiresAt: time.Now().Add(duration)}
+	return nil
+}
+
+func (s *memoryAttemptStore) LockedFor(ctx context.Context, key string) (time.Duration, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	entry, ok := s.entries[key]
+	if !ok {
+		return 0, nil
+	}
+
+	remaining := time.Until(entry.expiresAt)
+	if remaining <= 0 {
+		return 0, nil
+	}
+	return remaining, nil
+}
+
+func (s *memoryAttemptStore) Reset(ctx context.Context, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.entries, key)
+	return nil
+}
+
+// sweep drops expired entries about once a minute so the map doesn't grow unbounded
+func (s *memoryAttemptStore) sweep(now time.Time) {
+	if now.Sub(s.lastSweep) < time.Minute {
+		return
+	}
+
+	for key, entry := range s.entries {
+		if now.After(entry.expiresAt) {
+			delete(s.entries, key)
+		}
+	}
+	s.lastSweep = now
+}
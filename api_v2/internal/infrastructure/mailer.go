@@ -0,0 +1,117 @@
+package infrastructure
+
+import (
+	"fmt"
+	"net/smtp"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// Mailer defines the interface for delivering transactional emails
+// This allows switching between a local outbox (dev/tests) and a real SMTP server
+type Mailer interface {
+	Send(message EmailMessage) error
+}
+
+// EmailMessage represents a plain text email
+type EmailMessage struct {
+	To      string
+	Subject string
+	Body    string
+}
+
+// NewMailer creates the mailer selected by driver ("smtp" or "outbox")
+func NewMailer(driver, from, outboxDir, smtpHost, smtpPort, smtpUser, smtpPassword string) (Mailer, error) {
+	switch driver {
+	case "smtp":
+		return NewSMTPMailer(from, smtpHost, smtpPort, smtpUser, smtpPassword), nil
+	case "outbox", "":
+		return NewOutboxMailer(from, outboxDir)
+	default:
+		return nil, fmt.Errorf("unknown mail driver: %s", driver)
+	}
+}
+
+// OutboxMailer writes every email as an .eml file into a local directory
+type OutboxMailer struct {
+	from string
+	dir  string
+}
+
+func NewOutboxMailer(from, dir string) (*OutboxMailer, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create outbox directory: %w", err)
+	}
+
+	return &OutboxMailer{
+		from: from,
+		dir:  dir,
+	}, nil
+}
+
+func (m *OutboxMailer) Send(message EmailMessage) error {
+	suffix, err := GenerateOpaqueToken(4)
+	if err != nil {
+		return err
+	}
+
+	recipient := strings.NewReplacer("@", "_at_", "/", "_", "\\", "_").Replace(message.To)
+	filename := fmt.Sprintf("%s-%s-%s.eml", time.Now().UTC().Format("20060102T150405"), recipient, suffix)
+
+	if err := os.WriteFile(filepath.Join(m.dir, filename), buildEmail(m.from, message), 0o644); err != nil {
+		return fmt.Errorf("failed to write email to outbox: %w", err)
+	}
+
+	return nil
+}
+
+// SMTPMailer delivers emails through an SMTP server
+type SMTPMailer struct {
+	from     string
+	host     string
+	port     string
+	user     string
+	password string
+}
+
+func NewSMTPMailer(from, host, port, user, password string) *SMTPMailer {
+	return &SMTPMailer{
+		from:     from,
+		host:     host,
+		port:     port,
+		user:     user,
+		password: password,
+	}
+}
+
+func (m *SMTPMailer) Send(message EmailMessage) error {
+	var auth smtp.Auth
+	if m.user != "" {
+		auth = smtp.PlainAuth("", m.user, m.password, m.host)
+	}
+
+	addr := fmt.Sprintf("%s:%s", m.host, m.port)
+	if err := smtp.SendMail(addr, auth, m.from, []string{message.To}, buildEmail(m.from, message)); err != nil {
+		return fmt.Errorf("failed to send email: %w", err)
+	}
+
+	return nil
+}
+
+// headerSanitizer prevents header injection through user supplied values
+var headerSanitizer = strings.NewReplacer("\r", "", "\n", "")
+
+func buildEmail(from string, message EmailMessage) []byte {
+	var b strings.Builder
+	b.WriteString("From: " + headerSanitizer.Replace(from) + "\r\n")
+	b.WriteString("To: " + headerSanitizer.Replace(message.To) + "\r\n")
+	b.WriteString("Subject: " + headerSanitizer.Replace(message.Subject) + "\r\n")
+	b.WriteString("Date: " + time.Now().UTC().Format(time.RFC1123Z) + "\r\n")
+	b.WriteString("MIME-Version: 1.0\r\n")
+	b.WriteString("Content-Type: text/plain; charset=UTF-8\r\n")
+	b.WriteString("\r\n")
+	b.WriteString(message.Body)
+	return []byte(b.String())
+}
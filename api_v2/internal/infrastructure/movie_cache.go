@@ -0,0 +1,289 @@
+package infrastructure
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/EduardoMG12/cine/api_v2/internal/domain"
+	"github.com/google/uuid"
+)
+
+const (
+	movieCacheKeyPrefix       = "movie:ext:"
+	movieSearchCacheKeyPrefix = "movie:search:"
+)
+
+// cachedMovie is the value stored per external ID; NotFound entries are negative cache hits
+type cachedMovie struct {
+	NotFound bool          `json:"not_found,omitempty"`
+	Movie    *domain.Movie `json:"movie,omitempty"`
+}
+
+// MovieCacheStats counts cache lookups since the process started
+type MovieCacheStats struct {
+	Enabled       bool
+	Hits          int64
+	NegativeHits  int64
+	Misses        int64
+	SearchHits    int64
+	SearchMisses  int64
+	Invalidations int64
+	MovieTTL      time.Duration
+	SearchTTL     time.Duration
+	NotFoundTTL   time.Duration
+}
+
+// MovieCache keeps fetched movies and search results in Redis in front of the provider chain.
+// When Redis is unavailable every lookup is a miss.
+type MovieCache struct {
+	redis       *RedisService
+	movieTTL    time.Duration
+	searchTTL   time.Duration
+	notFoundTTL time.Duration
+
+	hits          atomic.Int64
+	negativeHits  atomic.Int64
+	misses        atomic.Int64
+	searchHits    atomic.Int64
+	searchMisses  atomic.Int64
+	invalidations atomic.Int64
+}
+
+func NewMovieCache(redis *RedisService, movieTTL, searchTTL, notFoundTTL time.Duration) *MovieCache {
+	return &MovieCache{
+		redis:       redis,
+		movieTTL:    movieTTL,
+		searchTTL:   searchTTL,
+		notFoundTTL: notFoundTTL,
+	}
+}
+
+func (c *MovieCache) enabled() bool {
+	return c != nil && c.redis != nil
+}
+
+// GetMovie returns the cached movie for an external ID. found is false on a miss;
+// a negative hit is found with a nil movie.
+func (c *MovieCache) GetMovie(ctx context.Context, externalID string) (movie *domain.Movie, found bool) {
+	if !c.enabled() {
+		return nil, false
+	}
+
+	var cached cachedMovie
+	if err := c.redis.Get(ctx, movieCacheKeyPrefix+externalID, &cached); err != nil {
+		c.misses.Add(1)
+		return nil, false
+	}
+
+	if cached.NotFound {
+		c.negativeHits.Add(1)
+		return nil, true
+	}
+
+	c.hits.Add(1)
+	return cached.Movie, true
+}
+
+// SetMovie caches a movie under its external ID.
+// The entry never outlives the movie's own cache_expires_at, so stale rows are not cached.
+func (c *MovieCache) SetMovie(ctx context.Context, movie *domain.Movie) {
+	if !c.enabled() || movie.ExternalAPIID == "" {
+		return
+	}
+
+	ttl := c.movieTTL
+	if movie.Provider != "internal" && !movie.CacheExpiresAt.IsZero() {
+		if remaining := time.Until(movie.CacheExpiresAt); remaining < ttl {
+			ttl = remaining
+		}
+	}
+	if ttl <= 0 {
+		return
+	}
+
+	if err := c.redis.Set(ctx, movieCacheKeyPrefix+movie.ExternalAPIID, cachedMovie{Movie: movie}, ttl); err != nil {
+		log.Printf("[MovieCache] Failed to cache movie %s: %v", movie.ExternalAPIID, err)
+	}
+}
+
+// SetNotFound remembers that no provider knows the external ID
+func (c *MovieCache) SetNotFound(ctx context.Context, externalID string) {
+	if !c.enabled() || c.notFoundTTL <= 0 {
+		return
+	}
+
+	if err := c.redis.Set(ctx, movieCacheKeyPrefix+externalID, cachedMovie{NotFound: true}, c.notFoundTTL); err != nil {
+		log.Printf("[MovieCache] Failed to cache missing movie %s: %v", externalID, err)
+	}
+}
+
+// GetSearch returns cached search results
+func (c *MovieCache) GetSearch(ctx context.Context, query string, page int) ([]*domain.Movie, bool) {
+	if !c.enabled() {
+		return nil, false
+	}
+
+	var movies []*domain.Movie
+	if err := c.redis.Get(ctx, searchCacheKey(query, page), &movies); err != nil {
+		c.searchMisses.Add(1)
+		return nil, false
+	}
+
+	c.searchHits.Add(1)
+	return movies, true
+}
+
+// SetSearch caches search results. Results are not invalidated when a movie changes,
+// so the search TTL should stay short.
+func (c *MovieCache) SetSearch(ctx context.Context, query string, page int, movies []*domain.Movie) {
+	if !c.enabled() || c.searchTTL <= 0 || len(movies) == 0 {
+		return
+	}
+
+	if err := c.redis.Set(ctx, searchCacheKey(query, page), movies, c.searchTTL); err != nil {
+		log.Printf("[MovieCache] Failed to cache search results: %v", err)
+	}
+}
+
+// Invalidate drops the cached entry of an external ID, positive or negative
+func (c *MovieCache) Invalidate(ctx context.Context, externalID string) {
+	if !c.enabled() || externalID == "" {
+		return
+	}
+
+	if err := c.redis.Delete(ctx, movieCacheKeyPrefix+externalID); err != nil {
+		log.Printf("[MovieCache] Failed to invalidate movie %s: %v", externalID, err)
+		return
+	}
+	c.invalidations.Add(1)
+}
+
+// Stats returns the hit and miss counters of this instance
+func (c *MovieCache) Stats() MovieCacheStats {
+	return MovieCacheStats{
+		Enabled:       c.enabled(),
+		Hits:          c.hits.Load(),
+		NegativeHits:  c.negativeHits.Load(),
+		Misses:        c.misses.Load(),
+		SearchHits:    c.searchHits.Load(),
+		SearchMisses:  c.searchMisses.Load(),
+		Invalidations: c.invalidations.Load(),
+		MovieTTL:      c.movieTTL,
+		SearchTTL:     c.searchTTL,
+		NotFoundTTL:   c.notFoundTTL,
+	}
+}
+
+func searchCacheKey(query string, page int) string {
+	normalized := strings.ToLower(strings.TrimSpace(query))
+	return fmt.Sprintf("%s%s:%d", movieSearchCacheKeyPrefix, HashToken(normalized), page)
+}
+
+// CacheMovieFetcher is the Redis link in front of the chain for FetchByExternalID and Search.
+// IDs no provider knows are cached as not found; failures caused by an unavailable provider are not.
+type CacheMovieFetcher struct {
+	BaseMovieFetcher
+	cache *MovieCache
+}
+
+func NewCacheMovieFetcher(cache *MovieCache) *CacheMovieFetcher {
+	return &CacheMovieFetcher{
+		cache: cache,
+	}
+}
+
+func (c *CacheMovieFetcher) GetProviderName() string {
+	return "Redis (Cache)"
+}
+
+func (c *CacheMovieFetcher) FetchByExternalID(ctx context.Context, externalID string) (*domain.Movie, error) {
+	if movie, found := c.cache.GetMovie(ctx, externalID); found {
+		if movie == nil {
+			log.Printf("[MovieFetcher] Cached as not found: %s", externalID)
+			return nil, fmt.Errorf("movie not found in any provider or local database")
+		}
+		log.Printf("[MovieFetcher] Movie found in cache: %s", movie.Title)
+		return movie, nil
+	}
+
+	movie, err := c.tryNext(ctx, nil, "fetchByExternalID", externalID)
+	if err != nil {
+		if !errors.Is(err, ErrProviderUnavailable) {
+			c.cache.SetNotFound(ctx, externalID)
+		}
+		return nil, err
+	}
+
+	// Movies requested by another ID (e.g. tmdb:603 for an IMDb movie) are cached under their own
+	// ID only, so writes to the movie always invalidate the cached copy
+	c.cache.SetMovie(ctx, movie)
+	return movie, nil
+}
+
+func (c *CacheMovieFetcher) FetchByTitle(ctx context.Context, title string, year string) (*domain.Movie, error) {
+	return c.tryNext(ctx, nil, "fetchByTitle", title, year)
+}
+
+func (c *CacheMovieFetcher) Search(ctx context.Context, query string, page int) ([]*domain.Movie, error) {
+	if movies, found := c.cache.GetSearch(ctx, query, page); found {
+		log.Printf("[MovieFetcher] Search results found in cache: %s (page: %d)", query, page)
+		return movies, nil
+	}
+
+	movies, err := c.tryNextSearch(ctx, nil, query, page)
+	if err != nil {
+		return nil, err
+	}
+
+	c.cache.SetSearch(ctx, query, page, movies)
+	return movies, nil
+}
+
+// cacheInvalidatingMovieRepository drops cached movies whenever they are written,
+// so edits and deletions are visible right away and new movies replace negative entries
+type cacheInvalidatingMovieRepository struct {
+	domain.MovieRepository
+	cache *MovieCache
+}
+
+// NewCacheInvalidatingMovieRepository wraps repo so writes invalidate the movie cache
+func NewCacheInvalidatingMovieRepository(repo domain.MovieRepository, cache *MovieCache) domain.MovieRepository {
+	return &cacheInvalidatingMovieRepository{
+		MovieRepository: repo,
+		cache:           cache,
+	}
+}
+
+func (r *cacheInvalidatingMovieRepository) CreateMovie(ctx context.Context, movie *domain.Movie) error {
+	if err := r.MovieRepository.CreateMovie(ctx, movie); err != nil {
+		return err
+	}
+	r.cache.Invalidate(ctx, movie.ExternalAPIID)
+	return nil
+}
+
+func (r *cacheInvalidatingMovieRepository) UpdateMovie(ctx context.Context, movie *domain.Movie) error {
+	if err := r.MovieRepository.UpdateMovie(ctx, movie); err != nil {
+		return err
+	}
+	r.cache.Invalidate(ctx, movie.ExternalAPIID)
+	return nil
+}
+
+func (r *cacheInvalidatingMovieRepository) DeleteMovie(ctx context.Context, id uuid.UUID) error {
+	movie, err := r.MovieRepository.GetMovieByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if err := r.MovieRepository.DeleteMovie(ctx, id); err != nil {
+		return err
+	}
+	r.cache.Invalidate(ctx, movie.ExternalAPIID)
+	return nil
+}
@@ -1,20 +1,24 @@
 package infrastructure
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/EduardoMG12/cine/api_v2/internal/domain"
+	"github.com/EduardoMG12/cine/api_v2/internal/dto"
 	"github.com/lib/pq"
 )
 
 // MovieFetcher defines the interface for fetching movies from different sources
 type MovieFetcher interface {
-	FetchByExternalID(externalID string) (*domain.Movie, error)
-	FetchByTitle(title string, year string) (*domain.Movie, error)
-	Search(query string, page int) ([]*domain.Movie, error)
+	FetchByExternalID(ctx context.Context, externalID string) (*domain.Movie, error)
+	FetchByTitle(ctx context.Context, title string, year string) (*domain.Movie, error)
+	Search(ctx context.Context, query string, page int) ([]*domain.Movie, error)
 	GetProviderName() string
 	SetNext(fetcher MovieFetcher)
 }
@@ -28,26 +32,56 @@ func (b *BaseMovieFetcher) SetNext(fetcher MovieFetcher) {
 	b.next = fetcher
 }
 
-func (b *BaseMovieFetcher) tryNext(operation string, args ...interface{}) (*domain.Movie, error) {
+// chainError is the failure of a later link that still carries the failure of the link before it,
+// so errors.Is(err, ErrProviderUnavailable) holds if any provider along the way was down
+type chainError struct {
+	err   error
+	cause error
+}
+
+func (e *chainError) Error() string {
+	return e.err.Error()
+}
+
+func (e *chainError) Unwrap() []error {
+	return []error{e.err, e.cause}
+}
+
+// tryNext hands the operation to the next link. cause is the failure of the current link,
+// kept in the returned error so callers can still inspect it.
+func (b *BaseMovieFetcher) tryNext(ctx context.Context, cause error, operation string, args ...interface{}) (*domain.Movie, error) {
 	if b.next == nil {
-		return nil, fmt.Errorf("no more providers available")
+		return nil, fmt.Errorf("no more providers available: %w", cause)
 	}
 
+	var movie *domain.Movie
+	var err error
+
 	switch operation {
 	case "fetchByExternalID":
-		return b.next.FetchByExternalID(args[0].(string))
+		movie, err = b.next.FetchByExternalID(ctx, args[0].(string))
 	case "fetchByTitle":
-		return b.next.FetchByTitle(args[0].(string), args[1].(string))
+		movie, err = b.next.FetchByTitle(ctx, args[0].(string), args[1].(string))
 	default:
 		return nil, fmt.Errorf("unknown operation: %s", operation)
 	}
+
+	if err != nil && cause != nil {
+		return nil, &chainError{err: err, cause: cause}
+	}
+	return movie, err
 }
 
-func (b *BaseMovieFetcher) tryNextSearch(query string, page int) ([]*domain.Movie, error) {
+func (b *BaseMovieFetcher) tryNextSearch(ctx context.Context, cause error, query string, page int) ([]*domain.Movie, error) {
 	if b.next == nil {
-		return nil, fmt.Errorf("no more providers available")
+		return nil, fmt.Errorf("no more providers available: %w", cause)
+	}
+
+	movies, err := b.next.Search(ctx, query, page)
+	if err != nil && cause != nil {
+		return nil, &chainError{err: err, cause: cause}
 	}
-	return b.next.Search(query, page)
+	return movies, err
 }
 
 // OMDbMovieFetcher wraps OMDbService to work with chain of responsibility
@@ -70,19 +104,24 @@ func (o *OMDbMovieFetcher) GetProviderName() string {
 	return "OMDb"
 }
 
-func (o *OMDbMovieFetcher) FetchByExternalID(externalID string) (*domain.Movie, error) {
+func (o *OMDbMovieFetcher) FetchByExternalID(ctx context.Context, externalID string) (*domain.Movie, error) {
+	// OMDb only knows IMDb IDs, asking it for anything else would spend a request on a miss
+	if !strings.HasPrefix(externalID, "tt") {
+		return o.tryNext(ctx, fmt.Errorf("OMDb only accepts IMDb IDs: %s", externalID), "fetchByExternalID", externalID)
+	}
+
 	log.Printf("[MovieFetcher] Trying OMDb for external ID: %s", externalID)
 
-	movieDetails, err := o.omdbService.GetMovieByExternalID(externalID)
+	movieDetails, err := o.omdbService.GetMovieByExternalID(ctx, externalID)
 	if err != nil {
 		log.Printf("[MovieFetcher] OMDb failed: %v. Trying next provider...", err)
-		return o.tryNext("fetchByExternalID", externalID)
+		return o.tryNext(ctx, err, "fetchByExternalID", externalID)
 	}
 
 	movie := o.convertToMovie(movieDetails)
 
 	if o.autoSave {
-		if err := o.saveToDatabase(movie); err != nil {
+		if err := o.saveToDatabase(ctx, movie); err != nil {
 			log.Printf("[MovieFetcher] Failed to save to database: %v", err)
 		} else {
 			log.Printf("[MovieFetcher] Movie saved to database: %s", movie.Title)
@@ -92,19 +131,19 @@ func (o *OMDbMovieFetcher) FetchByExternalID(externalID string) (*domain.Movie,
 	return movie, nil
 }
 
-func (o *OMDbMovieFetcher) FetchByTitle(title string, year string) (*domain.Movie, error) {
+func (o *OMDbMovieFetcher) FetchByTitle(ctx context.Context, title string, year string) (*domain.Movie, error) {
 	log.Printf("[MovieFetcher] Trying OMDb for title: %s (year: %s)", title, year)
 
-	movieDetails, err := o.omdbService.GetMovieByTitle(title, year)
+	movieDetails, err := o.omdbService.GetMovieByTitle(ctx, title, year)
 	if err != nil {
 		log.Printf("[MovieFetcher] OMDb failed: %v. Trying next provider...", err)
-		return o.tryNext("fetchByTitle", title, year)
+		return o.tryNext(ctx, err, "fetchByTitle", title, year)
 	}
 
 	movie := o.convertToMovie(movieDetails)
 
 	if o.autoSave {
-		if err := o.saveToDatabase(movie); err != nil {
+		if err := o.saveToDatabase(ctx, movie); err != nil {
 			log.Printf("[MovieFetcher] Failed to save to database: %v", err)
 		} else {
 			log.Printf("[MovieFetcher] Movie saved to database: %s", movie.Title)
@@ -114,13 +153,13 @@ func (o *OMDbMovieFetcher) FetchByTitle(title string, year string) (*domain.Movi
 	return movie, nil
 }
 
-func (o *OMDbMovieFetcher) Search(query string, page int) ([]*domain.Movie, error) {
+func (o *OMDbMovieFetcher) Search(ctx context.Context, query string, page int) ([]*domain.Movie, error) {
 	log.Printf("[MovieFetcher] Trying OMDb for search: %s (page: %d)", query, page)
 
-	searchResults, err := o.omdbService.SearchMovies(query, page)
+	searchResults, err := o.omdbService.SearchMovies(ctx, query, page)
 	if err != nil {
 		log.Printf("[MovieFetcher] OMDb search failed: %v. Trying next provider...", err)
-		return o.tryNextSearch(query, page)
+		return o.tryNextSearch(ctx, err, query, page)
 	}
 
 	movies := make([]*domain.Movie, 0, len(searchResults.Results))
@@ -128,14 +167,12 @@ func (o *OMDbMovieFetcher) Search(query string, page int) ([]*domain.Movie, erro
 		movie := &domain.Movie{
 			ExternalAPIID: item.IMDbID,
 			Provider:      "omdb",
+			TitleType:     omdbTitleType(item.Type),
 			Title:         item.Title,
 			LastSyncAt:    timePtr(time.Now()),
 			Genres:        pq.StringArray{}, // Initialize as empty array
 		}
 
-		if item.Year != "" {
-			movie.Overview = &item.Year
-		}
 		if item.Poster != "" && item.Poster != "N/A" {
 			movie.PosterURL = &item.Poster
 		}
@@ -144,7 +181,7 @@ func (o *OMDbMovieFetcher) Search(query string, page int) ([]*domain.Movie, erro
 		// Genres will be populated when user requests movie details (FetchByExternalID)
 
 		if o.autoSave {
-			if err := o.saveToDatabase(movie); err != nil {
+			if err := saveSearchResultToDatabase(ctx, o.movieRepo, movie); err != nil {
 				log.Printf("[MovieFetcher] Failed to save search result: %v", err)
 			}
 		}
@@ -159,6 +196,7 @@ func (o *OMDbMovieFetcher) convertToMovie(details *MovieDetails) *domain.Movie {
 	movie := &domain.Movie{
 		ExternalAPIID:  details.IMDbID,
 		Provider:       "omdb",
+		TitleType:      omdbTitleType(details.Type),
 		Title:          details.Title,
 		Adult:          details.Rated == "R" || details.Rated == "NC-17",
 		LastSyncAt:     timePtr(time.Now()),
@@ -203,17 +241,186 @@ func (o *OMDbMovieFetcher) convertToMovie(details *MovieDetails) *domain.Movie {
 	if details.Genre != "" && details.Genre != "N/A" {
 		movie.Genres = convertGenreStringToSlice(details.Genre)
 	}
+	if seasons, err := strconv.Atoi(details.TotalSeasons); err == nil && seasons > 0 {
+		movie.TotalSeasons = &seasons
+	}
+	applyOMDbMetadata(movie, details)
+
+	return movie
+}
+
+func (o *OMDbMovieFetcher) saveToDatabase(ctx context.Context, movie *domain.Movie) error {
+	return saveMovieToDatabase(ctx, o.movieRepo, movie)
+}
+
+// TMDbMovieFetcher wraps TMDbService to work with chain of responsibility
+type TMDbMovieFetcher struct {
+	BaseMovieFetcher
+	tmdbService *TMDbService
+	movieRepo   domain.MovieRepository
+	autoSave    bool
+}
+
+func NewTMDbMovieFetcher(tmdbService *TMDbService, movieRepo domain.MovieRepository, autoSave bool) *TMDbMovieFetcher {
+	return &TMDbMovieFetcher{
+		tmdbService: tmdbService,
+		movieRepo:   movieRepo,
+		autoSave:    autoSave,
+	}
+}
+
+func (t *TMDbMovieFetcher) GetProviderName() string {
+	return "TMDb"
+}
+
+func (t *TMDbMovieFetcher) FetchByExternalID(ctx context.Context, externalID string) (*domain.Movie, error) {
+	log.Printf("[MovieFetcher] Trying TMDb for external ID: %s", externalID)
+
+	movieDetails, err := t.tmdbService.GetMovieDetailsByExternalID(ctx, externalID)
+	if err != nil {
+		log.Printf("[MovieFetcher] TMDb failed: %v. Trying next provider...", err)
+		return t.tryNext(ctx, err, "fetchByExternalID", externalID)
+	}
+
+	return t.save(ctx, t.convertToMovie(movieDetails)), nil
+}
+
+func (t *TMDbMovieFetcher) FetchByTitle(ctx context.Context, title string, year string) (*domain.Movie, error) {
+	log.Printf("[MovieFetcher] Trying TMDb for title: %s (year: %s)", title, year)
+
+	results, err := t.tmdbService.Search(ctx, title, year, 1)
+	if err == nil && len(results.Results) == 0 {
+		err = fmt.Errorf("movie not found")
+	}
+	if err != nil {
+		log.Printf("[MovieFetcher] TMDb failed: %v. Trying next provider...", err)
+		return t.tryNext(ctx, err, "fetchByTitle", title, year)
+	}
+
+	movieDetails, err := t.tmdbService.GetMovieDetails(ctx, results.Results[0].ID)
+	if err != nil {
+		log.Printf("[MovieFetcher] TMDb failed: %v. Trying next provider...", err)
+		return t.tryNext(ctx, err, "fetchByTitle", title, year)
+	}
+
+	return t.save(ctx, t.convertToMovie(movieDetails)), nil
+}
+
+// Search results are not saved: TMDb only returns the IMDb ID with the full details,
+// so saving them here would store a second copy of movies already known by their IMDb ID
+func (t *TMDbMovieFetcher) Search(ctx context.Context, query string, page int) ([]*domain.Movie, error) {
+	log.Printf("[MovieFetcher] Trying TMDb for search: %s (page: %d)", query, page)
+
+	results, err := t.tmdbService.Search(ctx, query, "", page)
+	if err != nil {
+		log.Printf("[MovieFetcher] TMDb search failed: %v. Trying next provider...", err)
+		return t.tryNextSearch(ctx, err, query, page)
+	}
+
+	movies := make([]*domain.Movie, 0, len(results.Results))
+	for _, item := range results.Results {
+		movie := &domain.Movie{
+			ExternalAPIID: fmt.Sprintf("%s%d", tmdbIDPrefix, item.ID),
+			TMDbID:        intPtr(item.ID),
+			Provider:      "tmdb",
+			TitleType:     domain.TitleTypeMovie,
+			Title:         item.Title,
+			Adult:         item.Adult,
+			LastSyncAt:    timePtr(time.Now()),
+			Genres:        pq.StringArray(t.tmdbService.GenreNames(ctx, item.GenreIDs)),
+		}
+		t.applyCommonFields(movie, item.Overview, item.ReleaseDate, item.PosterPath, item.BackdropPath, item.VoteAverage, item.VoteCount)
+
+		movies = append(movies, movie)
+	}
+
+	return movies, nil
+}
+
+func (t *TMDbMovieFetcher) convertToMovie(details *dto.TMDbMovieResponse) *domain.Movie {
+	movie := &domain.Movie{
+		ExternalAPIID:  details.IMDbID,
+		TMDbID:         intPtr(details.ID),
+		Provider:       "tmdb",
+		TitleType:      domain.TitleTypeMovie,
+		Title:          details.Title,
+		Adult:          details.Adult,
+		LastSyncAt:     timePtr(time.Now()),
+		CacheExpiresAt: time.Now().Add(48 * time.Hour), // 2 days cache
+		Genres:         pq.StringArray{},
+	}
+
+	// Movies without an IMDb ID keep their TMDb ID so they can still be fetched again
+	if movie.ExternalAPIID == "" {
+		movie.ExternalAPIID = fmt.Sprintf("%s%d", tmdbIDPrefix, details.ID)
+	}
+
+	t.applyCommonFields(movie, details.Overview, details.ReleaseDate, details.PosterPath, details.BackdropPath, details.VoteAverage, details.VoteCount)
+
+	if details.Runtime > 0 {
+		runtime := details.Runtime
+		movie.Runtime = &runtime
+	}
+	for _, genre := range details.Genres {
+		movie.Genres = append(movie.Genres, genre.Name)
+	}
+	applyTMDbMetadata(movie, details)
 
 	return movie
 }
 
-func (o *OMDbMovieFetcher) saveToDatabase(movie *domain.Movie) error {
-	existing, err := o.movieRepo.GetMovieByExternalID(movie.ExternalAPIID)
+func (t *TMDbMovieFetcher) applyCommonFields(movie *domain.Movie, overview, releaseDate, posterPath, backdropPath string, voteAverage float64, voteCount int) {
+	if overview != "" {
+		movie.Overview = &overview
+	}
+	if releaseDate != "" {
+		if parsed, err := time.Parse("2006-01-02", releaseDate); err == nil {
+			movie.ReleaseDate = &parsed
+		}
+	}
+	if posterURL := t.tmdbService.ImageURL(posterPath, tmdbPosterSize); posterURL != "" {
+		movie.PosterURL = &posterURL
+	}
+	if backdropURL := t.tmdbService.ImageURL(backdropPath, tmdbBackdropSize); backdropURL != "" {
+		movie.BackdropURL = &backdropURL
+	}
+	if voteCount > 0 {
+		movie.VoteAverage = &voteAverage
+		movie.VoteCount = &voteCount
+	}
+}
+
+func (t *TMDbMovieFetcher) save(ctx context.Context, movie *domain.Movie) *domain.Movie {
+	if !t.autoSave {
+		return movie
+	}
+
+	if err := saveMovieToDatabase(ctx, t.movieRepo, movie); err != nil {
+		log.Printf("[MovieFetcher] Failed to save to database: %v", err)
+	} else {
+		log.Printf("[MovieFetcher] Movie saved to database: %s", movie.Title)
+	}
+	return movie
+}
+
+// saveMovieToDatabase creates the movie or updates the row with the same external ID
+func saveMovieToDatabase(ctx context.Context, movieRepo domain.MovieRepository, movie *domain.Movie) error {
+	existing, err := movieRepo.GetMovieByExternalID(ctx, movie.ExternalAPIID)
 	if err == nil && existing != nil {
 		movie.ID = existing.ID
-		return o.movieRepo.UpdateMovie(movie)
+		movie.CreatedAt = existing.CreatedAt
+		return movieRepo.UpdateMovie(ctx, movie)
+	}
+	return movieRepo.CreateMovie(ctx, movie)
+}
+
+// saveSearchResultToDatabase stores a search result the first time it is seen.
+// Search results only hold a title and a poster, so a stored movie is never overwritten by one.
+func saveSearchResultToDatabase(ctx context.Context, movieRepo domain.MovieRepository, movie *domain.Movie) error {
+	if existing, err := movieRepo.GetMovieByExternalID(ctx, movie.ExternalAPIID); err == nil && existing != nil {
+		return nil
 	}
-	return o.movieRepo.CreateMovie(movie)
+	return movieRepo.CreateMovie(ctx, movie)
 }
 
 func splitByComma(s string) []string {
@@ -239,6 +446,10 @@ func timePtr(t time.Time) *time.Time {
 	return &t
 }
 
+func intPtr(i int) *int {
+	return &i
+}
+
 // DatabaseMovieFetcher is the final fallback that searches in local database
 type DatabaseMovieFetcher struct {
 	BaseMovieFetcher
@@ -255,10 +466,10 @@ func (d *DatabaseMovieFetcher) GetProviderName() string {
 	return "Database (Local Cache)"
 }
 
-func (d *DatabaseMovieFetcher) FetchByExternalID(externalID string) (*domain.Movie, error) {
+func (d *DatabaseMovieFetcher) FetchByExternalID(ctx context.Context, externalID string) (*domain.Movie, error) {
 	log.Printf("[MovieFetcher] Trying local database for external ID: %s", externalID)
 
-	movie, err := d.movieRepo.GetMovieByExternalID(externalID)
+	movie, err := d.movieRepo.GetMovieByExternalID(ctx, externalID)
 	if err != nil {
 		log.Printf("[MovieFetcher] Database lookup failed: %v", err)
 		return nil, fmt.Errorf("movie not found in any provider or local database")
@@ -268,10 +479,10 @@ func (d *DatabaseMovieFetcher) FetchByExternalID(externalID string) (*domain.Mov
 	return movie, nil
 }
 
-func (d *DatabaseMovieFetcher) FetchByTitle(title string, year string) (*domain.Movie, error) {
+func (d *DatabaseMovieFetcher) FetchByTitle(ctx context.Context, title string, year string) (*domain.Movie, error) {
 	log.Printf("[MovieFetcher] Trying local database for title: %s", title)
 
-	movies, err := d.movieRepo.SearchMovies(title, 1)
+	movies, err := d.movieRepo.SearchMovies(ctx, title, 1)
 	if err != nil || len(movies) == 0 {
 		log.Printf("[MovieFetcher] Database search failed or no results")
 		return nil, fmt.Errorf("movie not found in any provider or local database")
@@ -292,11 +503,11 @@ func (d *DatabaseMovieFetcher) FetchByTitle(title string, year string) (*domain.
 	return movies[0], nil
 }
 
-func (d *DatabaseMovieFetcher) Search(query string, page int) ([]*domain.Movie, error) {
+func (d *DatabaseMovieFetcher) Search(ctx context.Context, query string, page int) ([]*domain.Movie, error) {
 	log.Printf("[MovieFetcher] Trying local database for search: %s", query)
 
 	limit := 10
-	movies, err := d.movieRepo.SearchMovies(query, limit)
+	movies, err := d.movieRepo.SearchMovies(ctx, query, limit)
 	if err != nil {
 		log.Printf("[MovieFetcher] Database search failed: %v", err)
 		return nil, fmt.Errorf("no results found in any provider or local database")
@@ -311,15 +522,312 @@ func (d *DatabaseMovieFetcher) Search(query string, page int) ([]*domain.Movie,
 	return movies, nil
 }
 
-// NewMovieFetcherChain creates a complete chain: OMDb -> Database
-func NewMovieFetcherChain(omdbService *OMDbService, movieRepo domain.MovieRepository, autoSave bool) MovieFetcher {
-	// Create fetchers
-	omdbFetcher := NewOMDbMovieFetcher(omdbService, movieRepo, autoSave)
-	dbFetcher := NewDatabaseMovieFetcher(movieRepo)
+// TMDbAliasMovieFetcher sits in front of the chain and swaps "tmdb:<id>" IDs for the external ID the
+// movie is stored under, usually its IMDb ID, so the cache, the stored movies and OMDb can serve it.
+// Movies never fetched from TMDb go down the chain with their TMDb ID.
+type TMDbAliasMovieFetcher struct {
+	BaseMovieFetcher
+	movieRepo domain.MovieRepository
+}
+
+func NewTMDbAliasMovieFetcher(movieRepo domain.MovieRepository) *TMDbAliasMovieFetcher {
+	return &TMDbAliasMovieFetcher{
+		movieRepo: movieRepo,
+	}
+}
+
+func (a *TMDbAliasMovieFetcher) GetProviderName() string {
+	return "TMDb IDs"
+}
+
+func (a *TMDbAliasMovieFetcher) FetchByExternalID(ctx context.Context, externalID string) (*domain.Movie, error) {
+	if tmdbID, err := strconv.Atoi(strings.TrimPrefix(externalID, tmdbIDPrefix)); err == nil && strings.HasPrefix(externalID, tmdbIDPrefix) {
+		if movie, err := a.movieRepo.GetMovieByTMDbID(ctx, tmdbID); err == nil && movie.ExternalAPIID != externalID {
+			log.Printf("[MovieFetcher] %s is stored as %s", externalID, movie.ExternalAPIID)
+			externalID = movie.ExternalAPIID
+		}
+	}
+	return a.tryNext(ctx, nil, "fetchByExternalID", externalID)
+}
+
+func (a *TMDbAliasMovieFetcher) FetchByTitle(ctx context.Context, title string, year string) (*domain.Movie, error) {
+	return a.tryNext(ctx, nil, "fetchByTitle", title, year)
+}
+
+func (a *TMDbAliasMovieFetcher) Search(ctx context.Context, query string, page int) ([]*domain.Movie, error) {
+	return a.tryNextSearch(ctx, nil, query, page)
+}
+
+// DatabaseFirstMovieFetcher serves movies already stored in the database before any provider is called.
+// Fresh rows (cache_expires_at in the future) are returned as is. Stale rows are returned right away
+// while the rest of the chain refreshes them in the background; only movies that are not stored at all
+// make the request wait for a provider. Rows created by hand (provider "internal") never go stale.
+type DatabaseFirstMovieFetcher struct {
+	BaseMovieFetcher
+	movieRepo domain.MovieRepository
+
+	refreshing     sync.Map      // external IDs being refreshed
+	slots          chan struct{} // bounds concurrent background refreshes
+	refreshTimeout time.Duration
+}
+
+func NewDatabaseFirstMovieFetcher(movieRepo domain.MovieRepository, refreshConcurrency int, refreshTimeout time.Duration) *DatabaseFirstMovieFetcher {
+	if refreshConcurrency < 1 {
+		refreshConcurrency = 1
+	}
+
+	return &DatabaseFirstMovieFetcher{
+		movieRepo:      movieRepo,
+		slots:          make(chan struct{}, refreshConcurrency),
+		refreshTimeout: refreshTimeout,
+	}
+}
+
+func (d *DatabaseFirstMovieFetcher) GetProviderName() string {
+	return "Database (Stored Movies)"
+}
+
+func (d *DatabaseFirstMovieFetcher) FetchByExternalID(ctx context.Context, externalID string) (*domain.Movie, error) {
+	movie, err := d.movieRepo.GetMovieByExternalID(ctx, externalID)
+	if err != nil {
+		return d.tryNext(ctx, nil, "fetchByExternalID", externalID)
+	}
+
+	// Rows saved from search results only hold a title and a poster, fetch them like missing ones
+	if movie.Provider != "internal" && movie.CacheExpiresAt.IsZero() {
+		return d.tryNext(ctx, nil, "fetchByExternalID", externalID)
+	}
+
+	if movie.Provider != "internal" && !time.Now().Before(movie.CacheExpiresAt) {
+		log.Printf("[MovieFetcher] Serving stale movie from database: %s", movie.Title)
+		d.refreshInBackground(ctx, externalID)
+		return movie, nil
+	}
+
+	log.Printf("[MovieFetcher] Movie found in database: %s", movie.Title)
+	return movie, nil
+}
+
+func (d *DatabaseFirstMovieFetcher) FetchByTitle(ctx context.Context, title string, year string) (*domain.Movie, error) {
+	return d.tryNext(ctx, nil, "fetchByTitle", title, year)
+}
+
+func (d *DatabaseFirstMovieFetcher) Search(ctx context.Context, query string, page int) ([]*domain.Movie, error) {
+	return d.tryNextSearch(ctx, nil, query, page)
+}
+
+// refreshInBackground fetches the movie again from the providers, which save it with a new expiration.
+// A movie is refreshed once at a time; refreshes are dropped while every slot is busy. The refresh
+// outlives the request, so it keeps the request's values but gets its own deadline.
+func (d *DatabaseFirstMovieFetcher) refreshInBackground(ctx context.Context, externalID string) {
+	if d.next == nil {
+		return
+	}
+	if _, alreadyRefreshing := d.refreshing.LoadOrStore(externalID, struct{}{}); alreadyRefreshing {
+		return
+	}
+
+	select {
+	case d.slots <- struct{}{}:
+	default:
+		d.refreshing.Delete(externalID)
+		log.Printf("[MovieFetcher] Too many refreshes in progress, skipping %s", externalID)
+		return
+	}
+
+	go func() {
+		defer func() {
+			<-d.slots
+			d.refreshing.Delete(externalID)
+		}()
+
+		ctx, cancel := withFetchTimeout(context.WithoutCancel(ctx), d.refreshTimeout)
+		defer cancel()
+
+		if _, err := d.next.FetchByExternalID(ctx, externalID); err != nil {
+			log.Printf("[MovieFetcher] Background refresh of %s failed: %v", externalID, err)
+			return
+		}
+		log.Printf("[MovieFetcher] Background refresh of %s done", externalID)
+	}()
+}
+
+// Names of the links accepted in MovieFetcherChainOptions.Providers
+const (
+	MovieProviderOMDb     = "omdb"
+	MovieProviderTMDb     = "tmdb"
+	MovieProviderDatabase = "database"
+)
+
+// MovieFetcherChainOptions configures the order and behaviour of the movie fetcher chain
+type MovieFetcherChainOptions struct {
+	Providers               []string // links in the order they are tried
+	AutoSave                bool
+	BreakerFailureThreshold int
+	BreakerOpenDuration     time.Duration
+	Cache                   *MovieCache   // Redis link placed in front of the chain when Redis is available
+	DatabaseFirst           bool          // serve stored movies before calling providers
+	RefreshConcurrency      int           // background refreshes of stale movies running at once
+	FetchTimeout            time.Duration // deadline of a lookup through the whole chain, 0 for none
+	// OMDbQuota switches the chain to database-only mode (providers skipped) while every OMDb key is
+	// exhausted. Only used when OMDb is one of the providers.
+	OMDbQuota *OMDbQuotaTracker
+}
+
+// MovieFetcherChain is the assembled chain
+type MovieFetcherChain struct {
+	// Fetcher is the entry point used to serve requests
+	Fetcher MovieFetcher
+	// Refresher starts at the first configured provider, skipping the cache and stored movies,
+	// so it always asks the providers. Nil when no provider is configured.
+	Refresher MovieFetcher
+	// Breakers guard the external providers, in chain order
+	Breakers []*CircuitBreaker
+}
+
+// Breaker returns the circuit breaker of a provider, e.g. "OMDb", or nil when it is not in the chain
+func (c *MovieFetcherChain) Breaker(provider string) *CircuitBreaker {
+	for _, breaker := range c.Breakers {
+		if breaker.Provider() == provider {
+			return breaker
+		}
+	}
+	return nil
+}
+
+// NewMovieFetcherChain builds the chain in the configured order (e.g. OMDb -> TMDb -> Database).
+// External providers are wrapped in circuit breakers, returned so their state can be reported.
+// Providers without a service (e.g. TMDb without an API key) are skipped.
+func NewMovieFetcherChain(
+	opts MovieFetcherChainOptions,
+	omdbService *OMDbService,
+	tmdbService *TMDbService,
+	movieRepo domain.MovieRepository,
+) (*MovieFetcherChain, error) {
+	links := make([]MovieFetcher, 0, len(opts.Providers)+2)
+	breakers := make([]*CircuitBreaker, 0, len(opts.Providers))
+
+	quotaGated, tmdbInChain := false, false
+	for _, provider := range opts.Providers {
+		quotaGated = quotaGated || (provider == MovieProviderOMDb && opts.OMDbQuota != nil && omdbService != nil)
+		tmdbInChain = tmdbInChain || (provider == MovieProviderTMDb && tmdbService != nil)
+	}
+
+	firstProvider := -1
+	guard := func(fetcher MovieFetcher) MovieFetcher {
+		if firstProvider < 0 {
+			firstProvider = len(links)
+		}
+		breaker := NewCircuitBreaker(fetcher.GetProviderName(), opts.BreakerFailureThreshold, opts.BreakerOpenDuration)
+		breakers = append(breakers, breaker)
+		if quotaGated {
+			fetcher = NewQuotaGateFetcher(fetcher, opts.OMDbQuota)
+		}
+		return NewCircuitBreakerFetcher(fetcher, breaker)
+	}
+
+	if tmdbInChain {
+		links = append(links, NewTMDbAliasMovieFetcher(movieRepo))
+	}
+	if opts.Cache.enabled() {
+		links = append(links, NewCacheMovieFetcher(opts.Cache))
+	}
+	if opts.DatabaseFirst {
+		links = append(links, NewDatabaseFirstMovieFetcher(movieRepo, opts.RefreshConcurrency, opts.FetchTimeout))
+	}
+
+	for _, provider := range opts.Providers {
+		switch provider {
+		case MovieProviderOMDb:
+			if omdbService == nil {
+				log.Printf("[MovieFetcher] OMDb is not configured, leaving it out of the chain")
+				continue
+			}
+			links = append(links, guard(NewOMDbMovieFetcher(omdbService, movieRepo, opts.AutoSave)))
+		case MovieProviderTMDb:
+			if tmdbService == nil {
+				log.Printf("[MovieFetcher] TMDb is not configured, leaving it out of the chain")
+				continue
+			}
+			links = append(links, guard(NewTMDbMovieFetcher(tmdbService, movieRepo, opts.AutoSave)))
+		case MovieProviderDatabase:
+			links = append(links, NewDatabaseMovieFetcher(movieRepo))
+		default:
+			return nil, fmt.Errorf("unknown movie provider: %s", provider)
+		}
+	}
+
+	if len(links) == 0 {
+		return nil, fmt.Errorf("movie fetcher chain has no providers")
+	}
+
+	for i := 0; i < len(links)-1; i++ {
+		links[i].SetNext(links[i+1])
+	}
 
-	omdbFetcher.SetNext(dbFetcher)
+	names := make([]string, len(links))
+	for i, link := range links {
+		names[i] = link.GetProviderName()
+	}
+	log.Printf("[MovieFetcher] Chain: %s", strings.Join(names, " -> "))
+
+	chain := &MovieFetcherChain{
+		Fetcher:  newTimeoutMovieFetcher(links[0], opts.FetchTimeout),
+		Breakers: breakers,
+	}
+	if firstProvider >= 0 {
+		chain.Refresher = newTimeoutMovieFetcher(links[firstProvider], opts.FetchTimeout)
+	}
 
-	return omdbFetcher
+	return chain, nil
+}
+
+// timeoutMovieFetcher bounds every lookup entering the chain with the configured deadline
+type timeoutMovieFetcher struct {
+	MovieFetcher
+	timeout time.Duration
+}
+
+func newTimeoutMovieFetcher(fetcher MovieFetcher, timeout time.Duration) MovieFetcher {
+	if timeout <= 0 {
+		return fetcher
+	}
+	return &timeoutMovieFetcher{MovieFetcher: fetcher, timeout: timeout}
+}
+
+func (t *timeoutMovieFetcher) FetchByExternalID(ctx context.Context, externalID string) (*domain.Movie, error) {
+	ctx, cancel := context.WithTimeout(ctx, t.timeout)
+	defer cancel()
+	return t.MovieFetcher.FetchByExternalID(ctx, externalID)
+}
+
+func (t *timeoutMovieFetcher) FetchByTitle(ctx context.Context, title string, year string) (*domain.Movie, error) {
+	ctx, cancel := context.WithTimeout(ctx, t.timeout)
+	defer cancel()
+	return t.MovieFetcher.FetchByTitle(ctx, title, year)
+}
+
+func (t *timeoutMovieFetcher) Search(ctx context.Context, query string, page int) ([]*domain.Movie, error) {
+	ctx, cancel := context.WithTimeout(ctx, t.timeout)
+	defer cancel()
+	return t.MovieFetcher.Search(ctx, query, page)
+}
+
+func withFetchTimeout(ctx context.Context, timeout time.Duration) (context.Context, context.CancelFunc) {
+	if timeout <= 0 {
+		return context.WithCancel(ctx)
+	}
+	return context.WithTimeout(ctx, timeout)
+}
+
+// omdbTitleType maps the OMDb type of a title; anything that is not a series or an episode is a movie
+func omdbTitleType(omdbType string) string {
+	switch omdbType {
+	case domain.TitleTypeSeries, domain.TitleTypeEpisode:
+		return omdbType
+	default:
+		return domain.TitleTypeMovie
+	}
 }
 
 func convertGenreStringToSlice(genreStr string) pq.StringArray {
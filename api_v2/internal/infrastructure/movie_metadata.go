@@ -0,0 +1,256 @@
+package infrastructure
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/EduardoMG12/cine/api_v2/internal/domain"
+	"github.com/EduardoMG12/cine/api_v2/internal/dto"
+	"github.com/lib/pq"
+)
+
+// Only the top-billed actors are kept; TMDb lists the whole cast
+const maxCastCredits = 20
+
+var (
+	awardWinsPattern        = regexp.MustCompile(`(\d+) wins?\b`)
+	awardNominationsPattern = regexp.MustCompile(`(\d+) nominations?\b`)
+	// OMDb writers come with their part, e.g. "Jonathan Nolan (screenplay)"
+	creditNotePattern = regexp.MustCompile(`\s*\([^)]*\)`)
+)
+
+// omdbRatingSources maps the source names used by OMDb to the rating sources we store
+var omdbRatingSources = map[string]string{
+	"Internet Movie Database": domain.RatingSourceIMDb,
+	"Rotten Tomatoes":         domain.RatingSourceRottenTomatoes,
+	"Metacritic":              domain.RatingSourceMetacritic,
+}
+
+// applyOMDbMetadata fills the languages, countries, awards, box office, credits and ratings of the movie
+func applyOMDbMetadata(movie *domain.Movie, details *MovieDetails) {
+	movie.Languages = splitProviderList(details.Language)
+	movie.Countries = splitProviderList(details.Country)
+
+	if details.Awards != "" && details.Awards != "N/A" {
+		awards := details.Awards
+		movie.Awards = &awards
+		movie.AwardWins, movie.AwardNominations = parseAwards(awards)
+	}
+	movie.BoxOffice = parseBoxOffice(details.BoxOffice)
+
+	movie.Credits = []domain.MovieCredit{}
+	movie.Credits = appendCredits(movie.Credits, domain.CreditRoleDirector, details.Director)
+	movie.Credits = appendCredits(movie.Credits, domain.CreditRoleWriter, details.Writer)
+	movie.Credits = appendCredits(movie.Credits, domain.CreditRoleActor, details.Actors)
+
+	movie.Ratings = omdbRatings(details)
+}
+
+// applyTMDbMetadata fills the languages, countries, box office, credits and rating of the movie.
+// TMDb has no awards; credits are left nil, keeping the stored ones, when the response has none.
+func applyTMDbMetadata(movie *domain.Movie, details *dto.TMDbMovieResponse) {
+	movie.Languages = pq.StringArray{}
+	for _, language := range details.SpokenLanguages {
+		movie.Languages = append(movie.Languages, language.EnglishName)
+	}
+	movie.Countries = pq.StringArray{}
+	for _, country := range details.ProductionCountries {
+		movie.Countries = append(movie.Countries, country.Name)
+	}
+	if details.Revenue > 0 {
+		revenue := details.Revenue
+		movie.BoxOffice = &revenue
+	}
+
+	if details.Credits != nil {
+		movie.Credits = tmdbCredits(details.Credits)
+	}
+
+	movie.Ratings = []domain.MovieRating{}
+	if details.VoteCount > 0 {
+		score := details.VoteAverage * 10
+		votes := details.VoteCount
+		movie.Ratings = append(movie.Ratings, domain.MovieRating{
+			Source: domain.RatingSourceTMDb,
+			Value:  fmt.Sprintf("%.1f/10", details.VoteAverage),
+			Score:  &score,
+			Votes:  &votes,
+		})
+	}
+}
+
+func tmdbCredits(credits *dto.TMDbCredits) []domain.MovieCredit {
+	result := []domain.MovieCredit{}
+
+	directors, writers := 0, 0
+	for _, member := range credits.Crew {
+		name := normalizePersonName(member.Name)
+		switch {
+		case name == "":
+			continue
+		case member.Job == "Director":
+			result = append(result, domain.MovieCredit{Name: name, Role: domain.CreditRoleDirector, BillingOrder: directors})
+			directors++
+		case member.Department == "Writing":
+			result = append(result, domain.MovieCredit{Name: name, Role: domain.CreditRoleWriter, BillingOrder: writers})
+			writers++
+		}
+	}
+
+	// The cast comes in billing order
+	for i, member := range credits.Cast {
+		if i == maxCastCredits {
+			break
+		}
+		name := normalizePersonName(member.Name)
+		if name == "" {
+			continue
+		}
+		credit := domain.MovieCredit{Name: name, Role: domain.CreditRoleActor, BillingOrder: i}
+		if member.Character != "" {
+			character := member.Character
+			credit.CharacterName = &character
+		}
+		result = append(result, credit)
+	}
+
+	return result
+}
+
+// appendCredits adds the people of a comma separated OMDb list, in the order given, skipping
+// repeated names (OMDb lists a writer once per part)
+func appendCredits(credits []domain.MovieCredit, role string, names string) []domain.MovieCredit {
+	seen := map[string]bool{}
+	for _, name := range splitProviderList(names) {
+		name = normalizePersonName(creditNotePattern.ReplaceAllString(name, ""))
+		if name == "" || seen[name] {
+			continue
+		}
+		seen[name] = true
+
+		credits = append(credits, domain.MovieCredit{Name: name, Role: role, BillingOrder: len(seen) - 1})
+	}
+	return credits
+}
+
+func omdbRatings(details *MovieDetails) []domain.MovieRating {
+	ratings := []domain.MovieRating{}
+	found := map[string]bool{}
+
+	for _, rating := range details.Ratings {
+		source, ok := omdbRatingSources[rating.Source]
+		if !ok || found[source] {
+			continue
+		}
+		found[source] = true
+		ratings = append(ratings, domain.MovieRating{Source: source, Value: rating.Value, Score: parseRatingScore(rating.Value)})
+	}
+
+	// The Ratings list is sometimes shorter than the dedicated fields
+	if !found[domain.RatingSourceIMDb] && details.IMDbRating != "" && details.IMDbRating != "N/A" {
+		value := details.IMDbRating + "/10"
+		ratings = append(ratings, domain.MovieRating{Source: domain.RatingSourceIMDb, Value: value, Score: parseRatingScore(value)})
+	}
+	if !found[domain.RatingSourceMetacritic] && details.Metascore != "" && details.Metascore != "N/A" {
+		value := details.Metascore + "/100"
+		ratings = append(ratings, domain.MovieRating{Source: domain.RatingSourceMetacritic, Value: value, Score: parseRatingScore(value)})
+	}
+
+	for i := range ratings {
+		if ratings[i].Source == domain.RatingSourceIMDb {
+			ratings[i].Votes = parseCount(details.IMDbVotes)
+		}
+	}
+
+	return ratings
+}
+
+// parseRatingScore converts "8.8/10", "74/100" or "87%" to a 0-100 score
+func parseRatingScore(value string) *float64 {
+	var score float64
+	if percent, ok := strings.CutSuffix(value, "%"); ok {
+		parsed, err := strconv.ParseFloat(percent, 64)
+		if err != nil {
+			return nil
+		}
+		score = parsed
+	} else {
+		rating, scale, ok := strings.Cut(value, "/")
+		if !ok {
+			return nil
+		}
+		numerator, err := strconv.ParseFloat(rating, 64)
+		if err != nil {
+			return nil
+		}
+		denominator, err := strconv.ParseFloat(scale, 64)
+		if err != nil || denominator <= 0 {
+			return nil
+		}
+		score = numerator / denominator * 100
+	}
+	return &score
+}
+
+// parseAwards reads the totals of an OMDb awards summary such as
+// "Won 4 Oscars. 159 wins & 220 nominations total"
+func parseAwards(summary string) (wins *int, nominations *int) {
+	if match := awardWinsPattern.FindStringSubmatch(summary); match != nil {
+		if count, err := strconv.Atoi(match[1]); err == nil {
+			wins = &count
+		}
+	}
+	if match := awardNominationsPattern.FindStringSubmatch(summary); match != nil {
+		if count, err := strconv.Atoi(match[1]); err == nil {
+			nominations = &count
+		}
+	}
+	return wins, nominations
+}
+
+// parseBoxOffice converts OMDb amounts such as "$292,587,330" to dollars
+func parseBoxOffice(value string) *int64 {
+	digits := strings.Map(func(r rune) rune {
+		if r >= '0' && r <= '9' {
+			return r
+		}
+		return -1
+	}, value)
+	amount, err := strconv.ParseInt(digits, 10, 64)
+	if err != nil || amount <= 0 {
+		return nil
+	}
+	return &amount
+}
+
+// parseCount converts OMDb counts such as "2,345,678"
+func parseCount(value string) *int {
+	digits := strings.ReplaceAll(value, ",", "")
+	count, err := strconv.Atoi(digits)
+	if err != nil {
+		return nil
+	}
+	return &count
+}
+
+// normalizePersonName trims names and collapses their inner spaces, since people are matched by name
+func normalizePersonName(name string) string {
+	return strings.Join(strings.Fields(name), " ")
+}
+
+// splitProviderList splits a comma separated provider list such as "English, Spanish"
+func splitProviderList(value string) pq.StringArray {
+	result := pq.StringArray{}
+	if value == "" || value == "N/A" {
+		return result
+	}
+
+	for _, item := range strings.Split(value, ",") {
+		if trimmed := strings.TrimSpace(item); trimmed != "" {
+			result = append(result, trimmed)
+		}
+	}
+	return result
+}
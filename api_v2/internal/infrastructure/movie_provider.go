@@ -1,16 +1,45 @@
 package infrastructure
 
+import (
+	"context"
+	"errors"
+	"net/url"
+)
+
+// ErrProviderUnavailable marks failures of the provider itself (network errors, timeouts, 5xx, bad keys)
+// as opposed to regular answers such as "movie not found". Only these trip the circuit breakers.
+var ErrProviderUnavailable = errors.New("provider unavailable")
+
+// redactRequestError drops the query from the URL of transport errors. Providers take their API key
+// as a query parameter and these errors end up in logs and in the provider status.
+func redactRequestError(err error) error {
+	var urlErr *url.Error
+	if !errors.As(err, &urlErr) {
+		return err
+	}
+
+	redacted := *urlErr
+	if parsed, parseErr := url.Parse(urlErr.URL); parseErr == nil {
+		parsed.RawQuery = ""
+		parsed.Fragment = ""
+		redacted.URL = parsed.String()
+	} else {
+		redacted.URL = "[redacted]"
+	}
+	return &redacted
+}
+
 // MovieProvider defines the interface for external movie data providers
 // This allows easy switching between OMDb, TMDb, or other APIs
 type MovieProvider interface {
 	// GetMovieByExternalID fetches movie details by external ID (IMDb ID, TMDb ID, etc.)
-	GetMovieByExternalID(id string) (*MovieDetails, error)
+	GetMovieByExternalID(ctx context.Context, id string) (*MovieDetails, error)
 
 	// GetMovieByTitle fetches movie details by title and optional year
-	GetMovieByTitle(title string, year string) (*MovieDetails, error)
+	GetMovieByTitle(ctx context.Context, title string, year string) (*MovieDetails, error)
 
 	// SearchMovies searches for movies by query
-	SearchMovies(query string, page int) (*SearchResults, error)
+	SearchMovies(ctx context.Context, query string, page int) (*SearchResults, error)
 
 	// GetProviderName returns the name of the provider (e.g., "OMDb", "TMDb")
 	GetProviderName() string
@@ -26,6 +55,9 @@ type MovieDetails struct {
 	Plot     string `json:"plot"`
 	Type     string `json:"type"` // movie, series, episode
 
+	// Series only
+	TotalSeasons string `json:"total_seasons,omitempty"`
+
 	// Media
 	Poster      string `json:"poster"`
 	BackdropURL string `json:"backdrop_url,omitempty"`
@@ -65,6 +97,24 @@ type Rating struct {
 	Value  string `json:"value"`
 }
 
+// SeasonDetails represents a season of a series with its episodes
+type SeasonDetails struct {
+	SeriesTitle  string        `json:"series_title"`
+	Season       int           `json:"season"`
+	TotalSeasons int           `json:"total_seasons"`
+	Episodes     []EpisodeItem `json:"episodes"`
+	Provider     string        `json:"provider"`
+}
+
+// EpisodeItem represents an episode listed in a season
+type EpisodeItem struct {
+	Title      string `json:"title"`
+	Released   string `json:"released"` // YYYY-MM-DD
+	Episode    int    `json:"episode"`
+	IMDbRating string `json:"imdb_rating"`
+	IMDbID     string `json:"imdb_id"`
+}
+
 // SearchResults represents unified search results from any provider
 type SearchResults struct {
 	Results      []SearchItem `json:"results"`
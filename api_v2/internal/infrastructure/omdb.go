@@ -1,49 +1,76 @@
 package infrastructure
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 	"net/url"
 	"strconv"
 	"time"
 )
 
-// OMDbService implements the MovieProvider interface for OMDb API
+// OMDbService implements the MovieProvider interface for OMDb API.
+// Every call is counted by the quota tracker, which also picks the API key to use.
 type OMDbService struct {
-	apiKey     string
+	quota      *OMDbQuotaTracker
 	baseURL    string
 	httpClient *http.Client
 }
 
 // OMDb API Response structures (internal)
 type omdbMovieResponse struct {
-	Title      string       `json:"Title"`
-	Year       string       `json:"Year"`
-	Rated      string       `json:"Rated"`
-	Released   string       `json:"Released"`
-	Runtime    string       `json:"Runtime"`
-	Genre      string       `json:"Genre"`
-	Director   string       `json:"Director"`
-	Writer     string       `json:"Writer"`
-	Actors     string       `json:"Actors"`
-	Plot       string       `json:"Plot"`
-	Language   string       `json:"Language"`
-	Country    string       `json:"Country"`
-	Awards     string       `json:"Awards"`
-	Poster     string       `json:"Poster"`
-	Ratings    []omdbRating `json:"Ratings"`
-	Metascore  string       `json:"Metascore"`
-	IMDbRating string       `json:"imdbRating"`
-	IMDbVotes  string       `json:"imdbVotes"`
-	IMDbID     string       `json:"imdbID"`
-	Type       string       `json:"Type"`
-	DVD        string       `json:"DVD"`
-	BoxOffice  string       `json:"BoxOffice"`
-	Production string       `json:"Production"`
-	Website    string       `json:"Website"`
-	Response   string       `json:"Response"`
-	Error      string       `json:"Error,omitempty"`
+	Title        string       `json:"Title"`
+	Year         string       `json:"Year"`
+	Rated        string       `json:"Rated"`
+	Released     string       `json:"Released"`
+	Runtime      string       `json:"Runtime"`
+	Genre        string       `json:"Genre"`
+	Director     string       `json:"Director"`
+	Writer       string       `json:"Writer"`
+	Actors       string       `json:"Actors"`
+	Plot         string       `json:"Plot"`
+	Language     string       `json:"Language"`
+	Country      string       `json:"Country"`
+	Awards       string       `json:"Awards"`
+	Poster       string       `json:"Poster"`
+	Ratings      []omdbRating `json:"Ratings"`
+	Metascore    string       `json:"Metascore"`
+	IMDbRating   string       `json:"imdbRating"`
+	IMDbVotes    string       `json:"imdbVotes"`
+	IMDbID       string       `json:"imdbID"`
+	Type         string       `json:"Type"`
+	DVD          string       `json:"DVD"`
+	BoxOffice    string       `json:"BoxOffice"`
+	Production   string       `json:"Production"`
+	Website      string       `json:"Website"`
+	TotalSeasons string       `json:"totalSeasons"`
+	Response     string       `json:"Response"`
+	Error        string       `json:"Error,omitempty"`
+}
+
+type omdbSeasonResponse struct {
+	Title        string            `json:"Title"`
+	Season       string            `json:"Season"`
+	TotalSeasons string            `json:"totalSeasons"`
+	Episodes     []omdbEpisodeItem `json:"Episodes"`
+	Response     string            `json:"Response"`
+	Error        string            `json:"Error,omitempty"`
+}
+
+type omdbEpisodeItem struct {
+	Title      string `json:"Title"`
+	Released   string `json:"Released"`
+	Episode    string `json:"Episode"`
+	IMDbRating string `json:"imdbRating"`
+	IMDbID     string `json:"imdbID"`
+}
+
+// omdbStatus holds the fields shared by every OMDb response
+type omdbStatus struct {
+	Response string `json:"Response"`
+	Error    string `json:"Error,omitempty"`
 }
 
 type omdbRating struct {
@@ -66,12 +93,12 @@ type omdbSearchItem struct {
 	Poster string `json:"Poster"`
 }
 
-func NewOMDbService(apiKey string) *OMDbService {
+func NewOMDbService(quota *OMDbQuotaTracker, baseURL string, timeout time.Duration) *OMDbService {
 	return &OMDbService{
-		apiKey:  apiKey,
-		baseURL: "http://www.omdbapi.com/",
+		quota:   quota,
+		baseURL: baseURL,
 		httpClient: &http.Client{
-			Timeout: 15 * time.Second,
+			Timeout: timeout,
 		},
 	}
 }
@@ -82,28 +109,15 @@ func (s *OMDbService) GetProviderName() string {
 }
 
 // GetMovieByExternalID fetches movie details by IMDb ID (implements MovieProvider)
-func (s *OMDbService) GetMovieByExternalID(imdbID string) (*MovieDetails, error) {
+func (s *OMDbService) GetMovieByExternalID(ctx context.Context, imdbID string) (*MovieDetails, error) {
 	params := url.Values{}
-	params.Add("apikey", s.apiKey)
 	params.Add("i", imdbID)
 	params.Add("plot", "full")
 	params.Add("r", "json")
 
-	fullURL := fmt.Sprintf("%s?%s", s.baseURL, params.Encode())
-
-	resp, err := s.httpClient.Get(fullURL)
-	if err != nil {
-		return nil, fmt.Errorf("failed to fetch movie from OMDb: %w", err)
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("OMDb API returned status code: %d", resp.StatusCode)
-	}
-
 	var omdbMovie omdbMovieResponse
-	if err := json.NewDecoder(resp.Body).Decode(&omdbMovie); err != nil {
-		return nil, fmt.Errorf("failed to decode OMDb response: %w", err)
+	if err := s.get(ctx, params, &omdbMovie); err != nil {
+		return nil, err
 	}
 
 	if omdbMovie.Response == "False" {
@@ -114,9 +128,8 @@ func (s *OMDbService) GetMovieByExternalID(imdbID string) (*MovieDetails, error)
 }
 
 // GetMovieByTitle fetches movie details by title (implements MovieProvider)
-func (s *OMDbService) GetMovieByTitle(title string, year string) (*MovieDetails, error) {
+func (s *OMDbService) GetMovieByTitle(ctx context.Context, title string, year string) (*MovieDetails, error) {
 	params := url.Values{}
-	params.Add("apikey", s.apiKey)
 	params.Add("t", title)
 	if year != "" {
 		params.Add("y", year)
@@ -124,21 +137,9 @@ func (s *OMDbService) GetMovieByTitle(title string, year string) (*MovieDetails,
 	params.Add("plot", "full")
 	params.Add("r", "json")
 
-	fullURL := fmt.Sprintf("%s?%s", s.baseURL, params.Encode())
-
-	resp, err := s.httpClient.Get(fullURL)
-	if err != nil {
-		return nil, fmt.Errorf("failed to fetch movie from OMDb: %w", err)
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("OMDb API returned status code: %d", resp.StatusCode)
-	}
-
 	var omdbMovie omdbMovieResponse
-	if err := json.NewDecoder(resp.Body).Decode(&omdbMovie); err != nil {
-		return nil, fmt.Errorf("failed to decode OMDb response: %w", err)
+	if err := s.get(ctx, params, &omdbMovie); err != nil {
+		return nil, err
 	}
 
 	if omdbMovie.Response == "False" {
@@ -149,7 +150,7 @@ func (s *OMDbService) GetMovieByTitle(title string, year string) (*MovieDetails,
 }
 
 // SearchMovies searches for movies by query (implements MovieProvider)
-func (s *OMDbService) SearchMovies(query string, page int) (*SearchResults, error) {
+func (s *OMDbService) SearchMovies(ctx context.Context, query string, page int) (*SearchResults, error) {
 	if page < 1 {
 		page = 1
 	}
@@ -158,26 +159,13 @@ func (s *OMDbService) SearchMovies(query string, page int) (*SearchResults, erro
 	}
 
 	params := url.Values{}
-	params.Add("apikey", s.apiKey)
 	params.Add("s", query)
 	params.Add("page", strconv.Itoa(page))
 	params.Add("r", "json")
 
-	fullURL := fmt.Sprintf("%s?%s", s.baseURL, params.Encode())
-
-	resp, err := s.httpClient.Get(fullURL)
-	if err != nil {
-		return nil, fmt.Errorf("failed to search movies from OMDb: %w", err)
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("OMDb API returned status code: %d", resp.StatusCode)
-	}
-
 	var omdbSearch omdbSearchResponse
-	if err := json.NewDecoder(resp.Body).Decode(&omdbSearch); err != nil {
-		return nil, fmt.Errorf("failed to decode OMDb search response: %w", err)
+	if err := s.get(ctx, params, &omdbSearch); err != nil {
+		return nil, err
 	}
 
 	if omdbSearch.Response == "False" {
@@ -190,7 +178,7 @@ func (s *OMDbService) SearchMovies(query string, page int) (*SearchResults, erro
 // Additional helper methods specific to OMDb
 
 // SearchMoviesByType searches with a specific type filter
-func (s *OMDbService) SearchMoviesByType(query string, movieType string, page int) (*SearchResults, error) {
+func (s *OMDbService) SearchMoviesByType(ctx context.Context, query string, movieType string, page int) (*SearchResults, error) {
 	if page < 1 {
 		page = 1
 	}
@@ -199,7 +187,6 @@ func (s *OMDbService) SearchMoviesByType(query string, movieType string, page in
 	}
 
 	params := url.Values{}
-	params.Add("apikey", s.apiKey)
 	params.Add("s", query)
 	params.Add("page", strconv.Itoa(page))
 	params.Add("r", "json")
@@ -208,21 +195,9 @@ func (s *OMDbService) SearchMoviesByType(query string, movieType string, page in
 		params.Add("type", movieType) // movie, series, episode
 	}
 
-	fullURL := fmt.Sprintf("%s?%s", s.baseURL, params.Encode())
-
-	resp, err := s.httpClient.Get(fullURL)
-	if err != nil {
-		return nil, fmt.Errorf("failed to search movies from OMDb: %w", err)
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("OMDb API returned status code: %d", resp.StatusCode)
-	}
-
 	var omdbSearch omdbSearchResponse
-	if err := json.NewDecoder(resp.Body).Decode(&omdbSearch); err != nil {
-		return nil, fmt.Errorf("failed to decode OMDb search response: %w", err)
+	if err := s.get(ctx, params, &omdbSearch); err != nil {
+		return nil, err
 	}
 
 	if omdbSearch.Response == "False" {
@@ -233,39 +208,109 @@ func (s *OMDbService) SearchMoviesByType(query string, movieType string, page in
 }
 
 // GetMovieByIMDbIDWithPlot allows specifying plot length
-func (s *OMDbService) GetMovieByIMDbIDWithPlot(imdbID string, plotType string) (*MovieDetails, error) {
+func (s *OMDbService) GetMovieByIMDbIDWithPlot(ctx context.Context, imdbID string, plotType string) (*MovieDetails, error) {
 	if plotType != "short" && plotType != "full" {
 		plotType = "full"
 	}
 
 	params := url.Values{}
-	params.Add("apikey", s.apiKey)
 	params.Add("i", imdbID)
 	params.Add("plot", plotType)
 	params.Add("r", "json")
 
+	var omdbMovie omdbMovieResponse
+	if err := s.get(ctx, params, &omdbMovie); err != nil {
+		return nil, err
+	}
+
+	if omdbMovie.Response == "False" {
+		return nil, fmt.Errorf("OMDb API error: %s", omdbMovie.Error)
+	}
+
+	return s.convertToMovieDetails(&omdbMovie), nil
+}
+
+// GetSeason fetches the episodes of a season of a series by the series' IMDb ID
+func (s *OMDbService) GetSeason(ctx context.Context, imdbID string, season int) (*SeasonDetails, error) {
+	params := url.Values{}
+	params.Add("i", imdbID)
+	params.Add("Season", strconv.Itoa(season))
+	params.Add("r", "json")
+
+	var omdbSeason omdbSeasonResponse
+	if err := s.get(ctx, params, &omdbSeason); err != nil {
+		return nil, err
+	}
+
+	if omdbSeason.Response == "False" {
+		return nil, fmt.Errorf("OMDb API error: %s", omdbSeason.Error)
+	}
+
+	return s.convertToSeasonDetails(&omdbSeason), nil
+}
+
+// get calls the API with the next key that has budget left. Keys OMDb reports as over their
+// daily limit are marked exhausted and the call is retried with another key.
+func (s *OMDbService) get(ctx context.Context, params url.Values, out interface{}) error {
+	for {
+		key, err := s.quota.Acquire(ctx)
+		if err != nil {
+			return fmt.Errorf("OMDb API error: %w", err)
+		}
+		params.Set("apikey", key)
+
+		body, err := s.fetch(ctx, params)
+		if err != nil {
+			return err
+		}
+
+		var status omdbStatus
+		if err := json.Unmarshal(body, &status); err != nil {
+			return fmt.Errorf("%w: failed to decode OMDb response: %w", ErrProviderUnavailable, err)
+		}
+		if status.Response == "False" && isOMDbLimitError(status.Error) {
+			s.quota.MarkExhausted(ctx, key)
+			continue
+		}
+
+		if err := json.Unmarshal(body, out); err != nil {
+			return fmt.Errorf("%w: failed to decode OMDb response: %w", ErrProviderUnavailable, err)
+		}
+		return nil
+	}
+}
+
+func (s *OMDbService) fetch(ctx context.Context, params url.Values) ([]byte, error) {
 	fullURL := fmt.Sprintf("%s?%s", s.baseURL, params.Encode())
 
-	resp, err := s.httpClient.Get(fullURL)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fullURL, nil)
 	if err != nil {
-		return nil, fmt.Errorf("failed to fetch movie from OMDb: %w", err)
+		return nil, fmt.Errorf("failed to build OMDb request: %w", redactRequestError(err))
 	}
-	defer resp.Body.Close()
 
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("OMDb API returned status code: %d", resp.StatusCode)
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to fetch from OMDb: %w", ErrProviderUnavailable, redactRequestError(err))
 	}
+	defer resp.Body.Close()
 
-	var omdbMovie omdbMovieResponse
-	if err := json.NewDecoder(resp.Body).Decode(&omdbMovie); err != nil {
-		return nil, fmt.Errorf("failed to decode OMDb response: %w", err)
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to read OMDb response: %w", ErrProviderUnavailable, err)
 	}
 
-	if omdbMovie.Response == "False" {
-		return nil, fmt.Errorf("OMDb API error: %s", omdbMovie.Error)
+	// OMDb answers 401 when a key is over its limit; the body says so and is handled by get
+	if resp.StatusCode == http.StatusUnauthorized {
+		var status omdbStatus
+		if json.Unmarshal(body, &status) == nil && isOMDbLimitError(status.Error) {
+			return body, nil
+		}
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%w: OMDb API returned status code: %d", ErrProviderUnavailable, resp.StatusCode)
 	}
 
-	return s.convertToMovieDetails(&omdbMovie), nil
+	return body, nil
 }
 
 // Conversion helpers
@@ -280,32 +325,59 @@ func (s *OMDbService) convertToMovieDetails(omdb *omdbMovieResponse) *MovieDetai
 	}
 
 	return &MovieDetails{
-		Title:      omdb.Title,
-		Year:       omdb.Year,
-		Released:   omdb.Released,
-		Runtime:    omdb.Runtime,
-		Plot:       omdb.Plot,
-		Type:       omdb.Type,
-		Poster:     omdb.Poster,
-		Rated:      omdb.Rated,
-		Genre:      omdb.Genre,
-		Language:   omdb.Language,
-		Country:    omdb.Country,
-		Director:   omdb.Director,
-		Writer:     omdb.Writer,
-		Actors:     omdb.Actors,
-		IMDbID:     omdb.IMDbID,
-		IMDbRating: omdb.IMDbRating,
-		IMDbVotes:  omdb.IMDbVotes,
-		Metascore:  omdb.Metascore,
-		Ratings:    ratings,
-		Awards:     omdb.Awards,
-		BoxOffice:  omdb.BoxOffice,
-		Production: omdb.Production,
-		Website:    omdb.Website,
-		Provider:   "OMDb",
-		ProviderID: omdb.IMDbID,
+		Title:        omdb.Title,
+		Year:         omdb.Year,
+		Released:     omdb.Released,
+		Runtime:      omdb.Runtime,
+		Plot:         omdb.Plot,
+		Type:         omdb.Type,
+		Poster:       omdb.Poster,
+		Rated:        omdb.Rated,
+		Genre:        omdb.Genre,
+		Language:     omdb.Language,
+		Country:      omdb.Country,
+		Director:     omdb.Director,
+		Writer:       omdb.Writer,
+		Actors:       omdb.Actors,
+		IMDbID:       omdb.IMDbID,
+		IMDbRating:   omdb.IMDbRating,
+		IMDbVotes:    omdb.IMDbVotes,
+		Metascore:    omdb.Metascore,
+		Ratings:      ratings,
+		Awards:       omdb.Awards,
+		BoxOffice:    omdb.BoxOffice,
+		Production:   omdb.Production,
+		Website:      omdb.Website,
+		TotalSeasons: omdb.TotalSeasons,
+		Provider:     "OMDb",
+		ProviderID:   omdb.IMDbID,
+	}
+}
+
+func (s *OMDbService) convertToSeasonDetails(omdb *omdbSeasonResponse) *SeasonDetails {
+	details := &SeasonDetails{
+		SeriesTitle: omdb.Title,
+		Episodes:    make([]EpisodeItem, 0, len(omdb.Episodes)),
+		Provider:    "OMDb",
 	}
+	details.Season, _ = strconv.Atoi(omdb.Season)
+	details.TotalSeasons, _ = strconv.Atoi(omdb.TotalSeasons)
+
+	for _, item := range omdb.Episodes {
+		episode, err := strconv.Atoi(item.Episode)
+		if err != nil {
+			continue
+		}
+		details.Episodes = append(details.Episodes, EpisodeItem{
+			Title:      item.Title,
+			Released:   item.Released,
+			Episode:    episode,
+			IMDbRating: item.IMDbRating,
+			IMDbID:     item.IMDbID,
+		})
+	}
+
+	return details
 }
 
 func (s *OMDbService) convertToSearchResults(omdb *omdbSearchResponse, page int) *SearchResults {
@@ -0,0 +1,299 @@
+package infrastructure
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/EduardoMG12/cine/api_v2/internal/domain"
+)
+
+const (
+	omdbQuotaKeyPrefix = "omdb:quota:"
+	// Counters outlive their day a little so late calls around midnight still find them
+	omdbQuotaCounterTTL = 48 * time.Hour
+)
+
+// ErrQuotaExhausted is returned when every OMDb key has used its daily budget.
+// It wraps ErrProviderUnavailable, but circuit breakers do not count it as a failure.
+var ErrQuotaExhausted = fmt.Errorf("%w: daily quota exhausted", ErrProviderUnavailable)
+
+// OMDbKeyQuota is the usage of one API key today
+type OMDbKeyQuota struct {
+	Key       string // masked, only the last characters are kept
+	Used      int
+	Remaining int
+	Exhausted bool
+}
+
+// OMDbQuotaStats reports today's usage of every configured key
+type OMDbQuotaStats struct {
+	DailyLimit int
+	Day        string
+	ResetsAt   time.Time
+	Keys       []OMDbKeyQuota
+	Remaining  int
+	Exhausted  bool
+}
+
+// OMDbQuotaTracker counts OMDb calls per API key per day (UTC) and rotates calls across the keys.
+// Counters live in Redis so every instance shares the budget; without Redis they are kept in memory.
+// A dailyLimit of 0 disables the limit, calls are still counted.
+type OMDbQuotaTracker struct {
+	keys       []string
+	dailyLimit int
+	redis      *RedisService
+
+	mu        sync.Mutex
+	day       string
+	next      int
+	local     map[int]int
+	exhausted map[int]bool
+}
+
+func NewOMDbQuotaTracker(keys []string, dailyLimit int, redis *RedisService) *OMDbQuotaTracker {
+	return &OMDbQuotaTracker{
+		keys:       keys,
+		dailyLimit: dailyLimit,
+		redis:      redis,
+		local:      map[int]int{},
+		exhausted:  map[int]bool{},
+	}
+}
+
+// Acquire picks the key for the next call and counts the call against it.
+// Keys are used in turn; ErrQuotaExhausted is returned once none has budget left today.
+func (t *OMDbQuotaTracker) Acquire(ctx context.Context) (string, error) {
+	if len(t.keys) == 0 {
+		return "", nil
+	}
+
+	t.mu.Lock()
+	day := t.rollover(time.Now())
+	start := t.next
+	t.next = (t.next + 1) % len(t.keys)
+	t.mu.Unlock()
+
+	for offset := 0; offset < len(t.keys); offset++ {
+		index := (start + offset) % len(t.keys)
+		if t.isExhausted(index) {
+			continue
+		}
+
+		used := t.increment(ctx, day, index)
+		if t.dailyLimit > 0 && used > t.dailyLimit {
+			t.markExhausted(index)
+			continue
+		}
+		return t.keys[index], nil
+	}
+
+	return "", ErrQuotaExhausted
+}
+
+// MarkExhausted records that OMDb refused a key for the rest of the day,
+// e.g. when the limit was reached outside this tracker
+func (t *OMDbQuotaTracker) MarkExhausted(ctx context.Context, key string) {
+	for index, candidate := range t.keys {
+		if candidate != key {
+			continue
+		}
+
+		t.mu.Lock()
+		day := t.rollover(time.Now())
+		t.mu.Unlock()
+
+		t.markExhausted(index)
+		if t.redis != nil && t.dailyLimit > 0 {
+			if err := t.redis.Set(ctx, t.counterKey(day, index), t.dailyLimit, omdbQuotaCounterTTL); err != nil {
+				log.Printf("[OMDbQuota] Failed to store exhausted key: %v", err)
+			}
+		}
+		log.Printf("[OMDbQuota] Key %s exhausted for today", maskAPIKey(key))
+		return
+	}
+}
+
+// Exhausted reports whether every key is known to have used its budget today.
+// Only keys that were refused by Acquire or OMDb count, so no Redis call is made.
+func (t *OMDbQuotaTracker) Exhausted() bool {
+	if t == nil || len(t.keys) == 0 || t.dailyLimit <= 0 {
+		return false
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.rollover(time.Now())
+	return len(t.exhausted) == len(t.keys)
+}
+
+// Stats returns today's usage per key
+func (t *OMDbQuotaTracker) Stats(ctx context.Context) OMDbQuotaStats {
+	now := time.Now().UTC()
+
+	t.mu.Lock()
+	day := t.rollover(now)
+	t.mu.Unlock()
+
+	stats := OMDbQuotaStats{
+		DailyLimit: t.dailyLimit,
+		Day:        day,
+		ResetsAt:   time.Date(now.Year(), now.Month(), now.Day()+1, 0, 0, 0, 0, time.UTC),
+		Keys:       make([]OMDbKeyQuota, 0, len(t.keys)),
+	}
+
+	exhaustedKeys := 0
+	for index, key := range t.keys {
+		quota := OMDbKeyQuota{
+			Key:       maskAPIKey(key),
+			Used:      t.used(ctx, day, index),
+			Exhausted: t.isExhausted(index),
+		}
+		if t.dailyLimit > 0 {
+			if quota.Used >= t.dailyLimit {
+				quota.Used = t.dailyLimit
+				quota.Exhausted = true
+			}
+			quota.Remaining = t.dailyLimit - quota.Used
+			if quota.Exhausted {
+				quota.Remaining = 0
+			}
+		}
+		if quota.Exhausted {
+			exhaustedKeys++
+		}
+
+		stats.Remaining += quota.Remaining
+		stats.Keys = append(stats.Keys, quota)
+	}
+	stats.Exhausted = t.dailyLimit > 0 && len(t.keys) > 0 && exhaustedKeys == len(t.keys)
+
+	return stats
+}
+
+// rollover resets the in-memory state when the UTC day changes and returns the current day.
+// Callers must hold t.mu.
+func (t *OMDbQuotaTracker) rollover(now time.Time) string {
+	day := now.UTC().Format("2006-01-02")
+	if day != t.day {
+		t.day = day
+		t.local = map[int]int{}
+		t.exhausted = map[int]bool{}
+	}
+	return day
+}
+
+func (t *OMDbQuotaTracker) isExhausted(index int) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.exhausted[index]
+}
+
+func (t *OMDbQuotaTracker) markExhausted(index int) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.exhausted[index] = true
+}
+
+// increment counts a call and returns the key's usage today, falling back to memory without Redis
+func (t *OMDbQuotaTracker) increment(ctx context.Context, day string, index int) int {
+	if t.redis != nil {
+		used, err := t.redis.Increment(ctx, t.counterKey(day, index), omdbQuotaCounterTTL)
+		if err == nil {
+			return int(used)
+		}
+		log.Printf("[OMDbQuota] Failed to count call in Redis, counting in memory: %v", err)
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.local[index]++
+	return t.local[index]
+}
+
+func (t *OMDbQuotaTracker) used(ctx context.Context, day string, index int) int {
+	if t.redis != nil {
+		var used int
+		err := t.redis.Get(ctx, t.counterKey(day, index), &used)
+		if err == nil {
+			return used
+		}
+		if err.Error() == "key not found" {
+			return 0
+		}
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.local[index]
+}
+
+// Keys are stored hashed so the API keys never reach Redis
+func (t *OMDbQuotaTracker) counterKey(day string, index int) string {
+	return fmt.Sprintf("%s%s:%s", omdbQuotaKeyPrefix, day, HashToken(t.keys[index]))
+}
+
+// isOMDbLimitError reports whether OMDb refused the call because the key's daily limit was reached
+func isOMDbLimitError(message string) bool {
+	return message == "Request limit reached!"
+}
+
+func maskAPIKey(key string) string {
+	if len(key) <= 4 {
+		return "****"
+	}
+	return "****" + key[len(key)-4:]
+}
+
+// QuotaGateFetcher skips a provider link while every OMDb key is exhausted, so the chain falls back
+// to the cache and stored movies until the quota resets. Like CircuitBreakerFetcher, the wrapped
+// fetcher must not have a next link of its own.
+type QuotaGateFetcher struct {
+	BaseMovieFetcher
+	fetcher MovieFetcher
+	quota   *OMDbQuotaTracker
+}
+
+func NewQuotaGateFetcher(fetcher MovieFetcher, quota *OMDbQuotaTracker) *QuotaGateFetcher {
+	return &QuotaGateFetcher{
+		fetcher: fetcher,
+		quota:   quota,
+	}
+}
+
+func (q *QuotaGateFetcher) GetProviderName() string {
+	return q.fetcher.GetProviderName()
+}
+
+func (q *QuotaGateFetcher) FetchByExternalID(ctx context.Context, externalID string) (*domain.Movie, error) {
+	if err := q.allow(); err != nil {
+		return nil, err
+	}
+	return q.fetcher.FetchByExternalID(ctx, externalID)
+}
+
+func (q *QuotaGateFetcher) FetchByTitle(ctx context.Context, title string, year string) (*domain.Movie, error) {
+	if err := q.allow(); err != nil {
+		return nil, err
+	}
+	return q.fetcher.FetchByTitle(ctx, title, year)
+}
+
+func (q *QuotaGateFetcher) Search(ctx context.Context, query string, page int) ([]*domain.Movie, error) {
+	if err := q.allow(); err != nil {
+		return nil, err
+	}
+	return q.fetcher.Search(ctx, query, page)
+}
+
+func (q *QuotaGateFetcher) allow() error {
+	if !q.quota.Exhausted() {
+		return nil
+	}
+
+	log.Printf("[MovieFetcher] OMDb quota exhausted, skipping %s", q.fetcher.GetProviderName())
+	return fmt.Errorf("%w: database-only mode until the quota resets", ErrQuotaExhausted)
+}
@@ -1,6 +1,17 @@
 package infrastructure
 
-import "golang.org/x/crypto/bcrypt"
+import (
+	"fmt"
+	"unicode"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+const (
+	MinPasswordLength = 8
+	// bcrypt ignores everything after 72 bytes
+	MaxPasswordLength = 72
+)
 
 type PasswordService struct{}
 
@@ -20,3 +31,29 @@ func (s *PasswordService) ComparePassword(hashedPassword, password string) bool
 	err := bcrypt.CompareHashAndPassword([]byte(hashedPassword), []byte(password))
 	return err == nil
 }
+
+// ValidateStrength enforces the password policy: 8 to 72 bytes with at least one letter and one digit
+func (s *PasswordService) ValidateStrength(password string) error {
+	if len(password) < MinPasswordLength {
+		return fmt.Errorf("password too short")
+	}
+	if len(password) > MaxPasswordLength {
+		return fmt.Errorf("password too long")
+	}
+
+	var hasLetter, hasDigit bool
+	for _, r := range password {
+		switch {
+		case unicode.IsLetter(r):
+			hasLetter = true
+		case unicode.IsDigit(r):
+			hasDigit = true
+		}
+	}
+
+	if !hasLetter || !hasDigit {
+		return fmt.Errorf("password too weak")
+	}
+
+	return nil
+}
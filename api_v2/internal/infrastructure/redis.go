@@ -66,6 +66,69 @@ func (s *RedisService) Exists(ctx context.Context, key string) (bool, error) {
 	return n > 0, nil
 }
 
+// Increment atomically increments a counter and (re)sets its expiration
+func (s *RedisService) Increment(ctx context.Context, key string, expiration time.Duration) (int64, error) {
+	pipe := s.client.TxPipeline()
+	incr := pipe.Incr(ctx, key)
+	pipe.Expire(ctx, key, expiration)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return 0, fmt.Errorf("failed to increment value: %w", err)
+	}
+	return incr.Val(), nil
+}
+
+// TTL returns the remaining time to live of a key, or 0 if it doesn't exist or never expires
+func (s *RedisService) TTL(ctx context.Context, key string) (time.Duration, error) {
+	ttl, err := s.client.PTTL(ctx, key).Result()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get ttl: %w", err)
+	}
+	if ttl < 0 {
+		return 0, nil
+	}
+	return ttl, nil
+}
+
+// Lock values are compared as plain strings, so they are stored without JSON encoding
+var (
+	refreshLockScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("PEXPIRE", KEYS[1], ARGV[2])
+end
+return 0`)
+	releaseLockScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("DEL", KEYS[1])
+end
+return 0`)
+)
+
+// AcquireLock sets key to token unless the key exists; reports whether the lock was taken
+func (s *RedisService) AcquireLock(ctx context.Context, key, token string, ttl time.Duration) (bool, error) {
+	ok, err := s.client.SetNX(ctx, key, token, ttl).Result()
+	if err != nil {
+		return false, fmt.Errorf("failed to acquire lock: %w", err)
+	}
+	return ok, nil
+}
+
+// RefreshLock extends the lock if it is still held with token
+func (s *RedisService) RefreshLock(ctx context.Context, key, token string, ttl time.Duration) (bool, error) {
+	n, err := refreshLockScript.Run(ctx, s.client, []string{key}, token, ttl.Milliseconds()).Int()
+	if err != nil {
+		return false, fmt.Errorf("failed to refresh lock: %w", err)
+	}
+	return n == 1, nil
+}
+
+// ReleaseLock deletes the lock if it is still held with token
+func (s *RedisService) ReleaseLock(ctx context.Context, key, token string) error {
+	if err := releaseLockScript.Run(ctx, s.client, []string{key}, token).Err(); err != nil {
+		return fmt.Errorf("failed to release lock: %w", err)
+	}
+	return nil
+}
+
 func (s *RedisService) Close() error {
 	return s.client.Close()
 }
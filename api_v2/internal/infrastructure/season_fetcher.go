@@ -0,0 +1,87 @@
+package infrastructure
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+)
+
+const seasonNotFoundKeyPrefix = "series:season:missing:"
+
+// ErrSeasonNotFound is returned for seasons OMDb doesn't know, including remembered misses
+var ErrSeasonNotFound = errors.New("season not found")
+
+// SeasonFetcher gets the seasons of series from OMDb. Calls go through the circuit breaker and the
+// quota of the OMDb link of the movie chain, and seasons OMDb doesn't know are remembered for
+// notFoundTTL so repeated requests don't spend the quota.
+type SeasonFetcher struct {
+	omdbService *OMDbService
+	breaker     *CircuitBreaker // nil when OMDb is not part of the chain
+	quota       *OMDbQuotaTracker
+	redis       *RedisService
+	notFoundTTL time.Duration
+}
+
+func NewSeasonFetcher(
+	omdbService *OMDbService,
+	breaker *CircuitBreaker,
+	quota *OMDbQuotaTracker,
+	redis *RedisService,
+	notFoundTTL time.Duration,
+) *SeasonFetcher {
+	return &SeasonFetcher{
+		omdbService: omdbService,
+		breaker:     breaker,
+		quota:       quota,
+		redis:       redis,
+		notFoundTTL: notFoundTTL,
+	}
+}
+
+// GetSeason returns the episodes of a season of a series by the series' IMDb ID
+func (f *SeasonFetcher) GetSeason(ctx context.Context, imdbID string, season int) (*SeasonDetails, error) {
+	if f.breaker == nil {
+		return nil, fmt.Errorf("%w: OMDb is not configured", ErrProviderUnavailable)
+	}
+
+	missingKey := fmt.Sprintf("%s%s:%d", seasonNotFoundKeyPrefix, imdbID, season)
+	if f.redis != nil {
+		if missing, err := f.redis.Exists(ctx, missingKey); err == nil && missing {
+			return nil, ErrSeasonNotFound
+		}
+	}
+
+	if f.quota != nil && f.quota.Exhausted() {
+		return nil, fmt.Errorf("%w: database-only mode until the quota resets", ErrQuotaExhausted)
+	}
+	if !f.breaker.Allow() {
+		return nil, fmt.Errorf("%w: %s circuit open", ErrProviderUnavailable, f.breaker.Provider())
+	}
+
+	start := time.Now()
+	details, err := f.omdbService.GetSeason(ctx, imdbID, season)
+	f.breaker.Record(time.Since(start), err)
+	if err == nil && len(details.Episodes) == 0 {
+		err = fmt.Errorf("OMDb API error: season has no episodes")
+	}
+	if err != nil {
+		if errors.Is(err, ErrProviderUnavailable) {
+			return nil, err
+		}
+		f.rememberMissing(ctx, missingKey)
+		return nil, fmt.Errorf("%w: %w", ErrSeasonNotFound, err)
+	}
+
+	return details, nil
+}
+
+func (f *SeasonFetcher) rememberMissing(ctx context.Context, key string) {
+	if f.redis == nil || f.notFoundTTL <= 0 {
+		return
+	}
+	if err := f.redis.Set(ctx, key, true, f.notFoundTTL); err != nil {
+		log.Printf("[SeasonFetcher] Failed to cache missing season %s: %v", key, err)
+	}
+}
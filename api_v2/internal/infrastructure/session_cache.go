@@ -0,0 +1,123 @@
+package infrastructure
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/EduardoMG12/cine/api_v2/internal/domain"
+	"github.com/google/uuid"
+)
+
+const (
+	sessionActiveKeyPrefix  = "session:active:"
+	sessionRevokedKeyPrefix = "session:revoked:"
+)
+
+// CachedSession is the minimal session data kept in Redis for authenticated requests
+type CachedSession struct {
+	SessionID uuid.UUID `json:"session_id"`
+	UserID    uuid.UUID `json:"user_id"`
+}
+
+// SessionCache keeps a short-lived view of session validity in Redis so the
+// auth middleware doesn't have to query PostgreSQL on every request.
+// When Redis is unavailable every lookup is a miss and the database stays the source of truth.
+type SessionCache struct {
+	redis     *RedisService
+	activeTTL time.Duration
+}
+
+func NewSessionCache(redis *RedisService, activeTTL time.Duration) *SessionCache {
+	return &SessionCache{
+		redis:     redis,
+		activeTTL: activeTTL,
+	}
+}
+
+func (c *SessionCache) enabled() bool {
+	return c != nil && c.redis != nil
+}
+
+// GetActive returns the cached session for a token, if it was recently validated
+func (c *SessionCache) GetActive(ctx context.Context, token string) (*CachedSession, bool) {
+	if !c.enabled() {
+		return nil, false
+	}
+
+	var cached CachedSession
+	if err := c.redis.Get(ctx, sessionActiveKeyPrefix+HashToken(token), &cached); err != nil {
+		return nil, false
+	}
+
+	return &cached, true
+}
+
+// SetActive caches a session validated against the database.
+// The entry never outlives the session itself.
+func (c *SessionCache) SetActive(ctx context.Context, token string, session *domain.UserSession) {
+	if !c.enabled() {
+		return
+	}
+
+	ttl := c.activeTTL
+	if remaining := time.Until(session.ExpiresAt); remaining < ttl {
+		ttl = remaining
+	}
+	if ttl <= 0 {
+		return
+	}
+
+	cached := CachedSession{
+		SessionID: session.ID,
+		UserID:    session.UserID,
+	}
+
+	if err := c.redis.Set(ctx, sessionActiveKeyPrefix+HashToken(token), cached, ttl); err != nil {
+		log.Printf("[SessionCache] Failed to cache session: %v", err)
+	}
+}
+
+// IsRevoked reports whether the token was explicitly revoked (logout, logout-all, ...)
+func (c *SessionCache) IsRevoked(ctx context.Context, token string) bool {
+	if !c.enabled() {
+		return false
+	}
+
+	revoked, err := c.redis.Exists(ctx, sessionRevokedKeyPrefix+HashToken(token))
+	if err != nil {
+		log.Printf("[SessionCache] Failed to check revocation: %v", err)
+		return false
+	}
+
+	return revoked
+}
+
+// Revoke drops the cached session and remembers the revocation until the session would have expired
+func (c *SessionCache) Revoke(ctx context.Context, token string, expiresAt time.Time) {
+	if !c.enabled() {
+		return
+	}
+
+	hash := HashToken(token)
+
+	if err := c.redis.Delete(ctx, sessionActiveKeyPrefix+hash); err != nil {
+		log.Printf("[SessionCache] Failed to drop cached session: %v", err)
+	}
+
+	ttl := time.Until(expiresAt)
+	if ttl <= 0 {
+		return
+	}
+
+	if err := c.redis.Set(ctx, sessionRevokedKeyPrefix+hash, true, ttl); err != nil {
+		log.Printf("[SessionCache] Failed to mark session as revoked: %v", err)
+	}
+}
+
+// RevokeSessions revokes every given session
+func (c *SessionCache) RevokeSessions(ctx context.Context, sessions []domain.UserSession) {
+	for _, session := range sessions {
+		c.Revoke(ctx, session.Token, session.ExpiresAt)
+	}
+}
@@ -0,0 +1,357 @@
+package infrastructure
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/EduardoMG12/cine/api_v2/internal/dto"
+)
+
+const (
+	tmdbPosterSize   = "w500"
+	tmdbBackdropSize = "w1280"
+	tmdbIDPrefix     = "tmdb:"
+)
+
+// TMDbService implements the MovieProvider interface for The Movie Database API (v3)
+type TMDbService struct {
+	apiKey       string
+	baseURL      string
+	imageBaseURL string
+	language     string
+	httpClient   *http.Client
+
+	genresMu sync.RWMutex
+	genres   map[int]string
+}
+
+type tmdbErrorResponse struct {
+	StatusCode    int    `json:"status_code"`
+	StatusMessage string `json:"status_message"`
+}
+
+// NewTMDbService creates a TMDb client. baseURL is the API root (e.g. https://api.themoviedb.org/3)
+// and imageBaseURL the image CDN root (e.g. https://image.tmdb.org/t/p)
+func NewTMDbService(apiKey, baseURL, imageBaseURL, language string, timeout time.Duration) *TMDbService {
+	return &TMDbService{
+		apiKey:       apiKey,
+		baseURL:      strings.TrimSuffix(baseURL, "/"),
+		imageBaseURL: strings.TrimSuffix(imageBaseURL, "/"),
+		language:     language,
+		httpClient: &http.Client{
+			Timeout: timeout,
+		},
+	}
+}
+
+// GetProviderName returns the name of this provider
+func (s *TMDbService) GetProviderName() string {
+	return "TMDb"
+}
+
+// GetMovieDetails fetches the details of a movie by its TMDb ID, along with its credits
+func (s *TMDbService) GetMovieDetails(ctx context.Context, tmdbID int) (*dto.TMDbMovieResponse, error) {
+	params := url.Values{}
+	params.Set("append_to_response", "credits")
+
+	var movie dto.TMDbMovieResponse
+	if err := s.get(ctx, fmt.Sprintf("/movie/%d", tmdbID), params, &movie); err != nil {
+		return nil, err
+	}
+	return &movie, nil
+}
+
+// FindByIMDbID resolves an IMDb ID to the matching TMDb movie
+func (s *TMDbService) FindByIMDbID(ctx context.Context, imdbID string) (*dto.TMDbMovieSearchResult, error) {
+	params := url.Values{}
+	params.Add("external_source", "imdb_id")
+
+	var found dto.TMDbFindResponse
+	if err := s.get(ctx, "/find/"+url.PathEscape(imdbID), params, &found); err != nil {
+		return nil, err
+	}
+
+	if len(found.MovieResults) == 0 {
+		return nil, fmt.Errorf("TMDb API error: movie not found")
+	}
+	return &found.MovieResults[0], nil
+}
+
+// Search searches movies by title, optionally restricted to a release year
+func (s *TMDbService) Search(ctx context.Context, query string, year string, page int) (*dto.TMDbSearchResponse, error) {
+	params := url.Values{}
+	params.Add("query", query)
+	params.Add("page", strconv.Itoa(clampTMDbPage(page)))
+	params.Add("include_adult", "false")
+	if year != "" {
+		params.Add("year", year)
+	}
+
+	var results dto.TMDbSearchResponse
+	if err := s.get(ctx, "/search/movie", params, &results); err != nil {
+		return nil, err
+	}
+	return &results, nil
+}
+
+// DiscoverByGenre lists movies of a TMDb genre, most popular first
+func (s *TMDbService) DiscoverByGenre(ctx context.Context, genreID int, page int) (*dto.TMDbDiscoverResponse, error) {
+	params := url.Values{}
+	params.Add("with_genres", strconv.Itoa(genreID))
+	params.Add("sort_by", "popularity.desc")
+	params.Add("page", strconv.Itoa(clampTMDbPage(page)))
+	params.Add("include_adult", "false")
+
+	var results dto.TMDbDiscoverResponse
+	if err := s.get(ctx, "/discover/movie", params, &results); err != nil {
+		return nil, err
+	}
+	return &results, nil
+}
+
+// GetPopular lists the currently popular movies
+func (s *TMDbService) GetPopular(ctx context.Context, page int) (*dto.TMDbSearchResponse, error) {
+	params := url.Values{}
+	params.Add("page", strconv.Itoa(clampTMDbPage(page)))
+
+	var results dto.TMDbSearchResponse
+	if err := s.get(ctx, "/movie/popular", params, &results); err != nil {
+		return nil, err
+	}
+	return &results, nil
+}
+
+// GetGenres returns the official movie genre list
+func (s *TMDbService) GetGenres(ctx context.Context) ([]dto.TMDbGenre, error) {
+	var response dto.TMDbGenresResponse
+	if err := s.get(ctx, "/genre/movie/list", nil, &response); err != nil {
+		return nil, err
+	}
+
+	genres := make(map[int]string, len(response.Genres))
+	for _, genre := range response.Genres {
+		genres[genre.ID] = genre.Name
+	}
+
+	s.genresMu.Lock()
+	s.genres = genres
+	s.genresMu.Unlock()
+
+	return response.Genres, nil
+}
+
+// GenreNames maps TMDb genre IDs to their names. The genre list is fetched once and kept in memory;
+// unknown IDs are skipped.
+func (s *TMDbService) GenreNames(ctx context.Context, ids []int) []string {
+	s.genresMu.RLock()
+	genres := s.genres
+	s.genresMu.RUnlock()
+
+	if genres == nil {
+		if _, err := s.GetGenres(ctx); err != nil {
+			return []string{}
+		}
+		s.genresMu.RLock()
+		genres = s.genres
+		s.genresMu.RUnlock()
+	}
+
+	names := make([]string, 0, len(ids))
+	for _, id := range ids {
+		if name, ok := genres[id]; ok {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// FindGenreID returns the TMDb ID of a genre by name (case insensitive)
+func (s *TMDbService) FindGenreID(ctx context.Context, name string) (int, error) {
+	genres, err := s.GetGenres(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, genre := range genres {
+		if strings.EqualFold(genre.Name, name) {
+			return genre.ID, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown TMDb genre: %s", name)
+}
+
+// GetMovieByExternalID fetches movie details by IMDb ID ("tt...") or TMDb ID ("tmdb:603" or "603")
+// (implements MovieProvider)
+func (s *TMDbService) GetMovieByExternalID(ctx context.Context, id string) (*MovieDetails, error) {
+	movie, err := s.GetMovieDetailsByExternalID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return s.convertToMovieDetails(movie), nil
+}
+
+// GetMovieDetailsByExternalID resolves any external ID accepted by GetMovieByExternalID to TMDb details
+func (s *TMDbService) GetMovieDetailsByExternalID(ctx context.Context, id string) (*dto.TMDbMovieResponse, error) {
+	if strings.HasPrefix(id, "tt") {
+		found, err := s.FindByIMDbID(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		return s.GetMovieDetails(ctx, found.ID)
+	}
+
+	tmdbID, err := strconv.Atoi(strings.TrimPrefix(id, tmdbIDPrefix))
+	if err != nil {
+		return nil, fmt.Errorf("invalid TMDb movie id: %s", id)
+	}
+	return s.GetMovieDetails(ctx, tmdbID)
+}
+
+// GetMovieByTitle fetches the details of the best match for a title and optional year
+// (implements MovieProvider)
+func (s *TMDbService) GetMovieByTitle(ctx context.Context, title string, year string) (*MovieDetails, error) {
+	results, err := s.Search(ctx, title, year, 1)
+	if err != nil {
+		return nil, err
+	}
+	if len(results.Results) == 0 {
+		return nil, fmt.Errorf("TMDb API error: movie not found")
+	}
+
+	movie, err := s.GetMovieDetails(ctx, results.Results[0].ID)
+	if err != nil {
+		return nil, err
+	}
+	return s.convertToMovieDetails(movie), nil
+}
+
+// SearchMovies searches for movies by query (implements MovieProvider)
+func (s *TMDbService) SearchMovies(ctx context.Context, query string, page int) (*SearchResults, error) {
+	results, err := s.Search(ctx, query, "", page)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]SearchItem, len(results.Results))
+	for i, item := range results.Results {
+		items[i] = SearchItem{
+			Title:      item.Title,
+			Year:       releaseYear(item.ReleaseDate),
+			Type:       "movie",
+			Poster:     s.ImageURL(item.PosterPath, tmdbPosterSize),
+			Genre:      strings.Join(s.GenreNames(ctx, item.GenreIDs), ", "),
+			ProviderID: strconv.Itoa(item.ID),
+		}
+	}
+
+	return &SearchResults{
+		Results:      items,
+		TotalResults: results.TotalResults,
+		Page:         results.Page,
+		TotalPages:   results.TotalPages,
+		Provider:     "TMDb",
+	}, nil
+}
+
+// ImageURL builds the full URL of a poster or backdrop path, or returns "" when there is no image
+func (s *TMDbService) ImageURL(path string, size string) string {
+	if path == "" {
+		return ""
+	}
+	return fmt.Sprintf("%s/%s%s", s.imageBaseURL, size, path)
+}
+
+func (s *TMDbService) get(ctx context.Context, path string, params url.Values, out interface{}) error {
+	if params == nil {
+		params = url.Values{}
+	}
+	params.Set("api_key", s.apiKey)
+	if s.language != "" {
+		params.Set("language", s.language)
+	}
+
+	fullURL := fmt.Sprintf("%s%s?%s", s.baseURL, path, params.Encode())
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fullURL, nil)
+	if err != nil {
+		return fmt.Errorf("failed to build TMDb request: %w", redactRequestError(err))
+	}
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("%w: failed to fetch from TMDb: %w", ErrProviderUnavailable, redactRequestError(err))
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		// A missing movie is an answer, anything else means TMDb can't serve requests right now
+		var apiErr tmdbErrorResponse
+		if err := json.NewDecoder(resp.Body).Decode(&apiErr); err == nil && apiErr.StatusMessage != "" {
+			if resp.StatusCode == http.StatusNotFound {
+				return fmt.Errorf("TMDb API error: %s", apiErr.StatusMessage)
+			}
+			return fmt.Errorf("%w: TMDb API error: %s", ErrProviderUnavailable, apiErr.StatusMessage)
+		}
+		return fmt.Errorf("%w: TMDb API returned status code: %d", ErrProviderUnavailable, resp.StatusCode)
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("%w: failed to decode TMDb response: %w", ErrProviderUnavailable, err)
+	}
+
+	return nil
+}
+
+func (s *TMDbService) convertToMovieDetails(movie *dto.TMDbMovieResponse) *MovieDetails {
+	genres := make([]string, len(movie.Genres))
+	for i, genre := range movie.Genres {
+		genres[i] = genre.Name
+	}
+
+	details := &MovieDetails{
+		Title:       movie.Title,
+		Year:        releaseYear(movie.ReleaseDate),
+		Released:    movie.ReleaseDate,
+		Plot:        movie.Overview,
+		Type:        "movie",
+		Poster:      s.ImageURL(movie.PosterPath, tmdbPosterSize),
+		BackdropURL: s.ImageURL(movie.BackdropPath, tmdbBackdropSize),
+		Genre:       strings.Join(genres, ", "),
+		IMDbID:      movie.IMDbID,
+		Ratings: []Rating{
+			{Source: "TMDb", Value: fmt.Sprintf("%.1f/10", movie.VoteAverage)},
+		},
+		Provider:   "TMDb",
+		ProviderID: strconv.Itoa(movie.ID),
+	}
+	if movie.Runtime > 0 {
+		details.Runtime = fmt.Sprintf("%d min", movie.Runtime)
+	}
+
+	return details
+}
+
+func releaseYear(releaseDate string) string {
+	if len(releaseDate) < 4 {
+		return ""
+	}
+	return releaseDate[:4]
+}
+
+// TMDb rejects pages above 500
+func clampTMDbPage(page int) int {
+	if page < 1 {
+		return 1
+	}
+	if page > 500 {
+		return 500
+	}
+	return page
+}
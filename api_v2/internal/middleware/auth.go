@@ -3,8 +3,10 @@ package middleware
 import (
 	"context"
 	"encoding/json"
+	"log"
 	"net/http"
 	"strings"
+	"time"
 
 	"github.com/EduardoMG12/cine/api_v2/internal/domain"
 	"github.com/EduardoMG12/cine/api_v2/internal/dto"
@@ -14,9 +16,21 @@ import (
 
 type contextKey string
 
-const UserContextKey contextKey = "user"
+const (
+	UserContextKey    contextKey = "user"
+	SessionContextKey contextKey = "session_id"
+)
+
+// sessionTouchInterval keeps last_used_at accurate to the minute. Without Redis every
+// request misses the session cache, so the write can't rely on the cache TTL alone.
+const sessionTouchInterval = time.Minute
 
-func JWTAuthMiddleware(jwtService *infrastructure.JWTService, userRepo domain.UserRepository) func(http.Handler) http.Handler {
+func JWTAuthMiddleware(
+	jwtService *infrastructure.JWTService,
+	userRepo domain.UserRepository,
+	sessionRepo domain.SessionRepository,
+	sessionCache *infrastructure.SessionCache,
+) func(http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			authHeader := r.Header.Get("Authorization")
@@ -32,19 +46,58 @@ func JWTAuthMiddleware(jwtService *infrastructure.JWTService, userRepo domain.Us
 			}
 
 			token := tokenParts[1]
-			userID, err := jwtService.ValidateToken(token)
+			if strings.HasPrefix(token, domain.PersonalAccessTokenPrefix) {
+				sendErrorResponse(w, http.StatusForbidden, "SESSION_REQUIRED", "Personal access tokens are not accepted on this route")
+				return
+			}
+
+			claims, err := jwtService.ValidateToken(token)
 			if err != nil {
 				sendErrorResponse(w, http.StatusUnauthorized, "UNAUTHORIZED", "Invalid or expired token")
 				return
 			}
 
-			user, err := userRepo.GetUserByID(userID)
+			// Tokens stay cryptographically valid after logout, so the session must still exist
+			if sessionCache.IsRevoked(r.Context(), token) {
+				sendErrorResponse(w, http.StatusUnauthorized, "SESSION_REVOKED", "Session has been revoked")
+				return
+			}
+
+			cached, ok := sessionCache.GetActive(r.Context(), token)
+			if !ok {
+				session, err := sessionRepo.GetSessionByToken(r.Context(), token)
+				if err != nil {
+					sendErrorResponse(w, http.StatusUnauthorized, "SESSION_REVOKED", "Session has been revoked or expired")
+					return
+				}
+
+				if session.LastUsedAt == nil || time.Since(*session.LastUsedAt) >= sessionTouchInterval {
+					if err := sessionRepo.TouchSession(r.Context(), session.ID); err != nil {
+						log.Printf("[Auth] Failed to update session activity: %v", err)
+					}
+				}
+
+				sessionCache.SetActive(r.Context(), token, session)
+				cached = &infrastructure.CachedSession{SessionID: session.ID, UserID: session.UserID}
+			}
+
+			if cached.UserID != claims.UserID {
+				sendErrorResponse(w, http.StatusUnauthorized, "UNAUTHORIZED", "Invalid or expired token")
+				return
+			}
+
+			user, err := userRepo.GetUserByID(r.Context(), claims.UserID)
 			if err != nil {
 				sendErrorResponse(w, http.StatusUnauthorized, "UNAUTHORIZED", "User not found")
 				return
 			}
+			if user.Disabled() {
+				sendErrorResponse(w, http.StatusForbidden, "ACCOUNT_DISABLED", "This account has been disabled")
+				return
+			}
 
 			ctx := context.WithValue(r.Context(), UserContextKey, user)
+			ctx = context.WithValue(ctx, SessionContextKey, cached.SessionID)
 			next.ServeHTTP(w, r.WithContext(ctx))
 		})
 	}
@@ -63,6 +116,12 @@ func GetUserIDFromContext(ctx context.Context) (uuid.UUID, bool) {
 	return user.ID, true
 }
 
+// GetSessionIDFromContext returns the ID of the session used to authenticate the request
+func GetSessionIDFromContext(ctx context.Context) (uuid.UUID, bool) {
+	sessionID, ok := ctx.Value(SessionContextKey).(uuid.UUID)
+	return sessionID, ok
+}
+
 func sendErrorResponse(w http.ResponseWriter, statusCode int, code, message string) {
 	response := dto.APIResponse{
 		Success: false,
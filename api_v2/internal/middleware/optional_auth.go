@@ -0,0 +1,22 @@
+package middleware
+
+import (
+	"net/http"
+)
+
+// OptionalAuth authenticates requests that carry an Authorization header with auth
+// and lets anonymous requests through unauthenticated
+func OptionalAuth(auth func(http.Handler) http.Handler) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		authenticated := auth(next)
+
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Header.Get("Authorization") == "" {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			authenticated.ServeHTTP(w, r)
+		})
+	}
+}
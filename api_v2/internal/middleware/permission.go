@@ -0,0 +1,26 @@
+package middleware
+
+import (
+	"net/http"
+)
+
+// RequirePermission only lets through users whose role grants the permission.
+// It must run after JWTAuthMiddleware.
+func RequirePermission(permission string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			user, ok := GetUserFromContext(r.Context())
+			if !ok {
+				sendErrorResponse(w, http.StatusUnauthorized, "UNAUTHORIZED", "User not authenticated")
+				return
+			}
+
+			if !user.HasPermission(permission) {
+				sendErrorResponse(w, http.StatusForbidden, "FORBIDDEN", "You don't have permission to perform this action")
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
@@ -0,0 +1,96 @@
+package middleware
+
+import (
+	"net"
+	"net/http"
+	"strings"
+)
+
+// RealIP sets RemoteAddr to the client IP given by X-Forwarded-For or X-Real-IP, but only when
+// the request comes from one of the trusted proxies (IPs or CIDRs). Any client can send these
+// headers, so requests from other peers keep their connection address.
+func RealIP(trustedProxies []string) func(http.Handler) http.Handler {
+	networks := ParseTrustedProxies(trustedProxies)
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if isTrustedProxy(networks, remoteIP(r.RemoteAddr)) {
+				if ip := forwardedClientIP(r, networks); ip != "" {
+					r.RemoteAddr = ip
+				}
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// ParseTrustedProxies parses IPs and CIDRs, skipping invalid entries
+func ParseTrustedProxies(values []string) []*net.IPNet {
+	networks := []*net.IPNet{}
+	for _, value := range values {
+		if network, ok := parseTrustedProxy(value); ok {
+			networks = append(networks, network)
+		}
+	}
+	return networks
+}
+
+func parseTrustedProxy(value string) (*net.IPNet, bool) {
+	if _, network, err := net.ParseCIDR(value); err == nil {
+		return network, true
+	}
+
+	ip := net.ParseIP(value)
+	if ip == nil {
+		return nil, false
+	}
+	bits := 128
+	if ip.To4() != nil {
+		ip = ip.To4()
+		bits = 32
+	}
+	return &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)}, true
+}
+
+// forwardedClientIP walks X-Forwarded-For from the right and returns the first address not
+// belonging to a trusted proxy; entries to its left were set by the client and can be forged
+func forwardedClientIP(r *http.Request, networks []*net.IPNet) string {
+	if xff := r.Header.Get("X-Forwarded-For"); xff != "" {
+		hops := strings.Split(xff, ",")
+		for i := len(hops) - 1; i >= 0; i-- {
+			ip := net.ParseIP(strings.TrimSpace(hops[i]))
+			if ip == nil {
+				return ""
+			}
+			if !isTrustedProxy(networks, ip) {
+				return ip.String()
+			}
+		}
+		return ""
+	}
+
+	if ip := net.ParseIP(strings.TrimSpace(r.Header.Get("X-Real-IP"))); ip != nil {
+		return ip.String()
+	}
+	return ""
+}
+
+func isTrustedProxy(networks []*net.IPNet, ip net.IP) bool {
+	if ip == nil {
+		return false
+	}
+	for _, network := range networks {
+		if network.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+func remoteIP(remoteAddr string) net.IP {
+	host, _, err := net.SplitHostPort(remoteAddr)
+	if err != nil {
+		host = remoteAddr
+	}
+	return net.ParseIP(host)
+}
@@ -0,0 +1,31 @@
+package middleware
+
+import (
+	"net/http"
+)
+
+// RequireVerifiedEmail blocks accounts that haven't confirmed their email yet.
+// It must run after JWTAuthMiddleware; when disabled it lets every request through.
+func RequireVerifiedEmail(enabled bool) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if !enabled {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			user, ok := GetUserFromContext(r.Context())
+			if !ok {
+				sendErrorResponse(w, http.StatusUnauthorized, "UNAUTHORIZED", "User not authenticated")
+				return
+			}
+
+			if !user.EmailVerified {
+				sendErrorResponse(w, http.StatusForbidden, "EMAIL_NOT_VERIFIED", "Please verify your email address first")
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
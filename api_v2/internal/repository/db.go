@@ -0,0 +1,56 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// DB bounds every query of the repositories with queryTimeout, on top of the deadline
+// of the request context. A zero timeout leaves only the request deadline.
+type DB struct {
+	*sqlx.DB
+	queryTimeout time.Duration
+}
+
+func NewDB(db *sqlx.DB, queryTimeout time.Duration) *DB {
+	return &DB{
+		DB:           db,
+		queryTimeout: queryTimeout,
+	}
+}
+
+func (db *DB) GetContext(ctx context.Context, dest interface{}, query string, args ...interface{}) error {
+	ctx, cancel := db.withTimeout(ctx)
+	defer cancel()
+	return db.DB.GetContext(ctx, dest, query, args...)
+}
+
+func (db *DB) SelectContext(ctx context.Context, dest interface{}, query string, args ...interface{}) error {
+	ctx, cancel := db.withTimeout(ctx)
+	defer cancel()
+	return db.DB.SelectContext(ctx, dest, query, args...)
+}
+
+func (db *DB) ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	ctx, cancel := db.withTimeout(ctx)
+	defer cancel()
+	return db.DB.ExecContext(ctx, query, args...)
+}
+
+func (db *DB) NamedExecContext(ctx context.Context, query string, arg interface{}) (sql.Result, error) {
+	ctx, cancel := db.withTimeout(ctx)
+	defer cancel()
+	return db.DB.NamedExecContext(ctx, query, arg)
+}
+
+// withTimeout is used directly by queries whose results outlive the call, such as transactions
+// and row cursors; the caller cancels once it is done with them
+func (db *DB) withTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
+	if db.queryTimeout <= 0 {
+		return context.WithCancel(ctx)
+	}
+	return context.WithTimeout(ctx, db.queryTimeout)
+}
@@ -0,0 +1,69 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/EduardoMG12/cine/api_v2/internal/domain"
+	"github.com/google/uuid"
+)
+
+type emailChangeTokenRepository struct {
+	db *DB
+}
+
+func NewEmailChangeTokenRepository(db *DB) domain.EmailChangeTokenRepository {
+	return &emailChangeTokenRepository{db: db}
+}
+
+func (r *emailChangeTokenRepository) CreateEmailChangeToken(ctx context.Context, token *domain.EmailChangeToken) error {
+	token.ID = uuid.New()
+	token.CreatedAt = time.Now()
+
+	query := `
+		INSERT INTO email_change_tokens (
+			id, user_id, session_id, new_email, token_hash, revoke_other_sessions, expires_at, created_at
+		) VALUES (
+			:id, :user_id, :session_id, :new_email, :token_hash, :revoke_other_sessions, :expires_at, :created_at
+		)
+	`
+
+	_, err := r.db.NamedExecContext(ctx, query, token)
+	if err != nil {
+		return fmt.Errorf("failed to create email change token: %w", err)
+	}
+
+	return nil
+}
+
+func (r *emailChangeTokenRepository) GetEmailChangeTokenByHash(ctx context.Context, tokenHash string) (*domain.EmailChangeToken, error) {
+	var token domain.EmailChangeToken
+	query := `
+		SELECT id, user_id, session_id, new_email, token_hash, revoke_other_sessions, expires_at, created_at
+		FROM email_change_tokens
+		WHERE token_hash = $1
+	`
+
+	err := r.db.GetContext(ctx, &token, query, tokenHash)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("email change token not found")
+		}
+		return nil, fmt.Errorf("failed to get email change token: %w", err)
+	}
+
+	return &token, nil
+}
+
+func (r *emailChangeTokenRepository) DeleteUserEmailChangeTokens(ctx context.Context, userID uuid.UUID) error {
+	query := `DELETE FROM email_change_tokens WHERE user_id = $1`
+
+	_, err := r.db.ExecContext(ctx, query, userID)
+	if err != nil {
+		return fmt.Errorf("failed to delete email change tokens: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,86 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/EduardoMG12/cine/api_v2/internal/domain"
+	"github.com/google/uuid"
+)
+
+type emailVerificationTokenRepository struct {
+	db *DB
+}
+
+func NewEmailVerificationTokenRepository(db *DB) domain.EmailVerificationTokenRepository {
+	return &emailVerificationTokenRepository{db: db}
+}
+
+func (r *emailVerificationTokenRepository) CreateEmailVerificationToken(ctx context.Context, token *domain.EmailVerificationToken) error {
+	token.ID = uuid.New()
+	token.CreatedAt = time.Now()
+
+	query := `
+		INSERT INTO email_verification_tokens (
+			id, user_id, token, expires_at, created_at
+		) VALUES (
+			:id, :user_id, :token, :expires_at, :created_at
+		)
+	`
+
+	_, err := r.db.NamedExecContext(ctx, query, token)
+	if err != nil {
+		return fmt.Errorf("failed to create email verification token: %w", err)
+	}
+
+	return nil
+}
+
+func (r *emailVerificationTokenRepository) GetEmailVerificationTokenByHash(ctx context.Context, tokenHash string) (*domain.EmailVerificationToken, error) {
+	var token domain.EmailVerificationToken
+	query := `
+		SELECT id, user_id, token, expires_at, created_at
+		FROM email_verification_tokens
+		WHERE token = $1
+	`
+
+	err := r.db.GetContext(ctx, &token, query, tokenHash)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("email verification token not found")
+		}
+		return nil, fmt.Errorf("failed to get email verification token: %w", err)
+	}
+
+	return &token, nil
+}
+
+func (r *emailVerificationTokenRepository) GetUserEmailVerificationTokensSince(ctx context.Context, userID uuid.UUID, since time.Time) ([]domain.EmailVerificationToken, error) {
+	var tokens []domain.EmailVerificationToken
+	query := `
+		SELECT id, user_id, token, expires_at, created_at
+		FROM email_verification_tokens
+		WHERE user_id = $1 AND created_at > $2
+		ORDER BY created_at DESC
+	`
+
+	err := r.db.SelectContext(ctx, &tokens, query, userID, since)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get email verification tokens: %w", err)
+	}
+
+	return tokens, nil
+}
+
+func (r *emailVerificationTokenRepository) DeleteUserEmailVerificationTokens(ctx context.Context, userID uuid.UUID) error {
+	query := `DELETE FROM email_verification_tokens WHERE user_id = $1`
+
+	_, err := r.db.ExecContext(ctx, query, userID)
+	if err != nil {
+		return fmt.Errorf("failed to delete email verification tokens: %w", err)
+	}
+
+	return nil
+}
@@ -1,23 +1,23 @@
 package repository
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 
 	"github.com/EduardoMG12/cine/api_v2/internal/domain"
 	"github.com/google/uuid"
-	"github.com/jmoiron/sqlx"
 )
 
 type favoriteMovieRepository struct {
-	db *sqlx.DB
+	db *DB
 }
 
-func NewFavoriteMovieRepository(db *sqlx.DB) domain.FavoriteMovieRepository {
+func NewFavoriteMovieRepository(db *DB) domain.FavoriteMovieRepository {
 	return &favoriteMovieRepository{db: db}
 }
 
-func (r *favoriteMovieRepository) AddFavoriteMovie(userID, movieID uuid.UUID) (*domain.FavoriteMovie, error) {
+func (r *favoriteMovieRepository) AddFavoriteMovie(ctx context.Context, userID, movieID uuid.UUID) (*domain.FavoriteMovie, error) {
 	var favorite domain.FavoriteMovie
 
 	query := `
@@ -28,7 +28,7 @@ func (r *favoriteMovieRepository) AddFavoriteMovie(userID, movieID uuid.UUID) (*
 		RETURNING id, user_id, movie_id, favorited_at, created_at
 	`
 
-	err := r.db.QueryRowx(query, userID, movieID).StructScan(&favorite)
+	err := r.db.GetContext(ctx, &favorite, query, userID, movieID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to add favorite movie: %w", err)
 	}
@@ -36,13 +36,13 @@ func (r *favoriteMovieRepository) AddFavoriteMovie(userID, movieID uuid.UUID) (*
 	return &favorite, nil
 }
 
-func (r *favoriteMovieRepository) RemoveFavoriteMovie(userID, movieID uuid.UUID) error {
+func (r *favoriteMovieRepository) RemoveFavoriteMovie(ctx context.Context, userID, movieID uuid.UUID) error {
 	query := `
 		DELETE FROM favorite_movies
 		WHERE user_id = $1 AND movie_id = $2
 	`
 
-	result, err := r.db.Exec(query, userID, movieID)
+	result, err := r.db.ExecContext(ctx, query, userID, movieID)
 	if err != nil {
 		return fmt.Errorf("failed to remove favorite movie: %w", err)
 	}
@@ -59,7 +59,7 @@ func (r *favoriteMovieRepository) RemoveFavoriteMovie(userID, movieID uuid.UUID)
 	return nil
 }
 
-func (r *favoriteMovieRepository) IsMovieFavorite(userID, movieID uuid.UUID) (bool, error) {
+func (r *favoriteMovieRepository) IsMovieFavorite(ctx context.Context, userID, movieID uuid.UUID) (bool, error) {
 	var exists bool
 	query := `
 		SELECT EXISTS(
@@ -68,7 +68,7 @@ func (r *favoriteMovieRepository) IsMovieFavorite(userID, movieID uuid.UUID) (bo
 		)
 	`
 
-	err := r.db.QueryRow(query, userID, movieID).Scan(&exists)
+	err := r.db.GetContext(ctx, &exists, query, userID, movieID)
 	if err != nil {
 		return false, fmt.Errorf("failed to check if movie is favorite: %w", err)
 	}
@@ -76,7 +76,7 @@ func (r *favoriteMovieRepository) IsMovieFavorite(userID, movieID uuid.UUID) (bo
 	return exists, nil
 }
 
-func (r *favoriteMovieRepository) GetUserFavoriteMovies(userID uuid.UUID) ([]domain.FavoriteMovie, error) {
+func (r *favoriteMovieRepository) GetUserFavoriteMovies(ctx context.Context, userID uuid.UUID) ([]domain.FavoriteMovie, error) {
 	var favorites []domain.FavoriteMovie
 
 	query := `
@@ -86,7 +86,7 @@ func (r *favoriteMovieRepository) GetUserFavoriteMovies(userID uuid.UUID) ([]dom
 		ORDER BY favorited_at DESC
 	`
 
-	err := r.db.Select(&favorites, query, userID)
+	err := r.db.SelectContext(ctx, &favorites, query, userID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get user favorite movies: %w", err)
 	}
@@ -0,0 +1,38 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/EduardoMG12/cine/api_v2/internal/domain"
+	"github.com/google/uuid"
+)
+
+type loginLockoutRepository struct {
+	db *DB
+}
+
+func NewLoginLockoutRepository(db *DB) domain.LoginLockoutRepository {
+	return &loginLockoutRepository{db: db}
+}
+
+func (r *loginLockoutRepository) CreateLoginLockout(ctx context.Context, lockout *domain.LoginLockout) error {
+	lockout.ID = uuid.New()
+	lockout.CreatedAt = time.Now()
+
+	query := `
+		INSERT INTO login_lockouts (
+			id, scope, identifier, failures, ip_address, user_agent, locked_until, created_at
+		) VALUES (
+			:id, :scope, :identifier, :failures, :ip_address, :user_agent, :locked_until, :created_at
+		)
+	`
+
+	_, err := r.db.NamedExecContext(ctx, query, lockout)
+	if err != nil {
+		return fmt.Errorf("failed to create login lockout: %w", err)
+	}
+
+	return nil
+}
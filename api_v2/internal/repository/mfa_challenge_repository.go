@@ -0,0 +1,82 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/EduardoMG12/cine/api_v2/internal/domain"
+	"github.com/google/uuid"
+)
+
+type mfaChallengeRepository struct {
+	db *DB
+}
+
+func NewMFAChallengeRepository(db *DB) domain.MFAChallengeRepository {
+	return &mfaChallengeRepository{db: db}
+}
+
+func (r *mfaChallengeRepository) CreateMFAChallenge(ctx context.Context, challenge *domain.MFAChallenge) error {
+	challenge.ID = uuid.New()
+	challenge.CreatedAt = time.Now()
+
+	query := `
+		INSERT INTO mfa_challenges (id, user_id, token_hash, attempts, expires_at, created_at)
+		VALUES (:id, :user_id, :token_hash, :attempts, :expires_at, :created_at)
+	`
+
+	_, err := r.db.NamedExecContext(ctx, query, challenge)
+	if err != nil {
+		return fmt.Errorf("failed to create mfa challenge: %w", err)
+	}
+
+	return nil
+}
+
+func (r *mfaChallengeRepository) GetMFAChallengeByHash(ctx context.Context, tokenHash string) (*domain.MFAChallenge, error) {
+	var challenge domain.MFAChallenge
+	query := `
+		SELECT id, user_id, token_hash, attempts, expires_at, created_at
+		FROM mfa_challenges
+		WHERE token_hash = $1
+	`
+
+	err := r.db.GetContext(ctx, &challenge, query, tokenHash)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("mfa challenge not found")
+		}
+		return nil, fmt.Errorf("failed to get mfa challenge: %w", err)
+	}
+
+	return &challenge, nil
+}
+
+func (r *mfaChallengeRepository) UseMFAChallengeAttempt(ctx context.Context, id uuid.UUID, maxAttempts int) (bool, error) {
+	query := `UPDATE mfa_challenges SET attempts = attempts + 1 WHERE id = $1 AND attempts < $2`
+
+	result, err := r.db.ExecContext(ctx, query, id, maxAttempts)
+	if err != nil {
+		return false, fmt.Errorf("failed to update mfa challenge: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	return rowsAffected > 0, nil
+}
+
+func (r *mfaChallengeRepository) DeleteMFAChallenge(ctx context.Context, id uuid.UUID) error {
+	query := `DELETE FROM mfa_challenges WHERE id = $1`
+
+	_, err := r.db.ExecContext(ctx, query, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete mfa challenge: %w", err)
+	}
+
+	return nil
+}
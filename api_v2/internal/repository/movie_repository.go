@@ -1,8 +1,10 @@
 package repository
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/EduardoMG12/cine/api_v2/internal/domain"
@@ -12,49 +14,53 @@ import (
 )
 
 type movieRepository struct {
-	db *sqlx.DB
+	db *DB
 }
 
-func NewMovieRepository(db *sqlx.DB) domain.MovieRepository {
+func NewMovieRepository(db *DB) domain.MovieRepository {
 	return &movieRepository{db: db}
 }
 
-func (r *movieRepository) CreateMovie(movie *domain.Movie) error {
+func (r *movieRepository) CreateMovie(ctx context.Context, movie *domain.Movie) error {
 	movie.ID = uuid.New()
 	movie.CreatedAt = time.Now()
 	movie.UpdatedAt = time.Now()
+	if movie.Provider == "" {
+		movie.Provider = "internal"
+	}
+	if movie.TitleType == "" {
+		movie.TitleType = domain.TitleTypeMovie
+	}
 
 	query := `
 		INSERT INTO movies (
 			id, external_api_id, title, overview, release_date, poster_url, 
 			backdrop_url, genres, runtime, vote_average, vote_count, adult, 
-			cache_expires_at, created_at, updated_at
+			languages, countries, awards, award_wins, award_nominations, box_office,
+			provider, title_type, total_seasons, tmdb_id, last_sync_at, cache_expires_at, created_at, updated_at
 		) VALUES (
 			:id, :external_api_id, :title, :overview, :release_date, :poster_url,
 			:backdrop_url, :genres, :runtime, :vote_average, :vote_count, :adult,
-			:cache_expires_at, :created_at, :updated_at
+			:languages, :countries, :awards, :award_wins, :award_nominations, :box_office,
+			:provider, :title_type, :total_seasons, :tmdb_id, :last_sync_at, :cache_expires_at, :created_at, :updated_at
 		)
 	`
 
-	_, err := r.db.NamedExec(query, movie)
-	if err != nil {
-		return fmt.Errorf("failed to create movie: %w", err)
-	}
-
-	return nil
+	return r.saveMovie(ctx, query, movie, "failed to create movie")
 }
 
-func (r *movieRepository) GetMovieByID(id uuid.UUID) (*domain.Movie, error) {
+func (r *movieRepository) GetMovieByID(ctx context.Context, id uuid.UUID) (*domain.Movie, error) {
 	var movie domain.Movie
 	query := `
 		SELECT id, external_api_id, title, overview, release_date, poster_url,
 			   backdrop_url, genres, runtime, vote_average, vote_count, adult,
-			   cache_expires_at, created_at, updated_at
+			   languages, countries, awards, award_wins, award_nominations, box_office,
+			   provider, title_type, total_seasons, tmdb_id, last_sync_at, cache_expires_at, created_at, updated_at
 		FROM movies 
 		WHERE id = $1
 	`
 
-	err := r.db.Get(&movie, query, id)
+	err := r.db.GetContext(ctx, &movie, query, id)
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return nil, fmt.Errorf("movie not found")
@@ -65,17 +71,41 @@ func (r *movieRepository) GetMovieByID(id uuid.UUID) (*domain.Movie, error) {
 	return &movie, nil
 }
 
-func (r *movieRepository) GetMovieByExternalID(externalID string) (*domain.Movie, error) {
+func (r *movieRepository) GetMoviesByIDs(ctx context.Context, ids []uuid.UUID) ([]*domain.Movie, error) {
+	movies := []*domain.Movie{}
+	if len(ids) == 0 {
+		return movies, nil
+	}
+
+	query := `
+		SELECT id, external_api_id, title, overview, release_date, poster_url,
+			   backdrop_url, genres, runtime, vote_average, vote_count, adult,
+			   languages, countries, awards, award_wins, award_nominations, box_office,
+			   provider, title_type, total_seasons, tmdb_id, last_sync_at, cache_expires_at, created_at, updated_at
+		FROM movies
+		WHERE id = ANY($1)
+	`
+
+	err := r.db.SelectContext(ctx, &movies, query, pq.Array(ids))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get movies by ids: %w", err)
+	}
+
+	return movies, nil
+}
+
+func (r *movieRepository) GetMovieByExternalID(ctx context.Context, externalID string) (*domain.Movie, error) {
 	var movie domain.Movie
 	query := `
 		SELECT id, external_api_id, title, overview, release_date, poster_url,
 			   backdrop_url, genres, runtime, vote_average, vote_count, adult,
-			   cache_expires_at, created_at, updated_at
+			   languages, countries, awards, award_wins, award_nominations, box_office,
+			   provider, title_type, total_seasons, tmdb_id, last_sync_at, cache_expires_at, created_at, updated_at
 		FROM movies 
 		WHERE external_api_id = $1
 	`
 
-	err := r.db.Get(&movie, query, externalID)
+	err := r.db.GetContext(ctx, &movie, query, externalID)
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return nil, fmt.Errorf("movie not found")
@@ -86,9 +116,34 @@ func (r *movieRepository) GetMovieByExternalID(externalID string) (*domain.Movie
 	return &movie, nil
 }
 
-func (r *movieRepository) UpdateMovie(movie *domain.Movie) error {
+func (r *movieRepository) GetMovieByTMDbID(ctx context.Context, tmdbID int) (*domain.Movie, error) {
+	var movie domain.Movie
+	query := `
+		SELECT id, external_api_id, title, overview, release_date, poster_url,
+			   backdrop_url, genres, runtime, vote_average, vote_count, adult,
+			   languages, countries, awards, award_wins, award_nominations, box_office,
+			   provider, title_type, total_seasons, tmdb_id, last_sync_at, cache_expires_at, created_at, updated_at
+		FROM movies
+		WHERE tmdb_id = $1
+		ORDER BY updated_at DESC
+		LIMIT 1
+	`
+
+	err := r.db.GetContext(ctx, &movie, query, tmdbID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("movie not found")
+		}
+		return nil, fmt.Errorf("failed to get movie by tmdb id: %w", err)
+	}
+
+	return &movie, nil
+}
+
+func (r *movieRepository) UpdateMovie(ctx context.Context, movie *domain.Movie) error {
 	movie.UpdatedAt = time.Now()
 
+	// Not every provider returns these, so a missing value keeps the stored one
 	query := `
 		UPDATE movies SET
 			title = :title,
@@ -101,23 +156,124 @@ func (r *movieRepository) UpdateMovie(movie *domain.Movie) error {
 			vote_average = :vote_average,
 			vote_count = :vote_count,
 			adult = :adult,
+			languages = COALESCE(:languages, languages),
+			countries = COALESCE(:countries, countries),
+			awards = COALESCE(:awards, awards),
+			award_wins = COALESCE(:award_wins, award_wins),
+			award_nominations = COALESCE(:award_nominations, award_nominations),
+			box_office = COALESCE(:box_office, box_office),
+			provider = :provider,
+			title_type = COALESCE(NULLIF(:title_type, ''), title_type),
+			total_seasons = COALESCE(:total_seasons, total_seasons),
+			tmdb_id = COALESCE(:tmdb_id, tmdb_id),
+			last_sync_at = :last_sync_at,
 			cache_expires_at = :cache_expires_at,
 			updated_at = :updated_at
 		WHERE id = :id
 	`
 
-	_, err := r.db.NamedExec(query, movie)
+	return r.saveMovie(ctx, query, movie, "failed to update movie")
+}
+
+// saveMovie runs the insert or update of the movie row and replaces its credits and ratings
+// in the same transaction, when the movie carries them
+func (r *movieRepository) saveMovie(ctx context.Context, query string, movie *domain.Movie, failure string) error {
+	ctx, cancel := r.db.withTimeout(ctx)
+	defer cancel()
+
+	tx, err := r.db.BeginTxx(ctx, nil)
 	if err != nil {
-		return fmt.Errorf("failed to update movie: %w", err)
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.NamedExecContext(ctx, query, movie); err != nil {
+		return fmt.Errorf("%s: %w", failure, err)
+	}
+
+	if movie.Credits != nil {
+		if err := replaceMovieCredits(ctx, tx, movie.ID, movie.Credits); err != nil {
+			return err
+		}
+	}
+	if movie.Ratings != nil {
+		if err := replaceMovieRatings(ctx, tx, movie.ID, movie.Ratings); err != nil {
+			return err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit movie: %w", err)
 	}
 
 	return nil
 }
 
-func (r *movieRepository) DeleteMovie(id uuid.UUID) error {
+// replaceMovieCredits creates the people missing by name and links them to the movie,
+// filling in the person IDs of credits
+func replaceMovieCredits(ctx context.Context, tx *sqlx.Tx, movieID uuid.UUID, credits []domain.MovieCredit) error {
+	if _, err := tx.ExecContext(ctx, `DELETE FROM movie_credits WHERE movie_id = $1`, movieID); err != nil {
+		return fmt.Errorf("failed to delete movie credits: %w", err)
+	}
+
+	// The no-op update makes RETURNING give the ID of people that already exist
+	personQuery := `
+		INSERT INTO people (id, name, created_at, updated_at)
+		VALUES ($1, $2, NOW(), NOW())
+		ON CONFLICT (name) DO UPDATE SET name = EXCLUDED.name
+		RETURNING id
+	`
+	creditQuery := `
+		INSERT INTO movie_credits (movie_id, person_id, role, character_name, billing_order)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (movie_id, person_id, role) DO NOTHING
+	`
+
+	for i := range credits {
+		credit := &credits[i]
+		if err := tx.GetContext(ctx, &credit.PersonID, personQuery, uuid.New(), credit.Name); err != nil {
+			return fmt.Errorf("failed to save person: %w", err)
+		}
+		credit.MovieID = movieID
+
+		_, err := tx.ExecContext(ctx, creditQuery, movieID, credit.PersonID, credit.Role, credit.CharacterName, credit.BillingOrder)
+		if err != nil {
+			return fmt.Errorf("failed to save movie credit: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func replaceMovieRatings(ctx context.Context, tx *sqlx.Tx, movieID uuid.UUID, ratings []domain.MovieRating) error {
+	if _, err := tx.ExecContext(ctx, `DELETE FROM movie_ratings WHERE movie_id = $1`, movieID); err != nil {
+		return fmt.Errorf("failed to delete movie ratings: %w", err)
+	}
+
+	query := `
+		INSERT INTO movie_ratings (movie_id, source, value, score, votes, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT (movie_id, source) DO NOTHING
+	`
+
+	now := time.Now()
+	for i := range ratings {
+		rating := &ratings[i]
+		rating.MovieID = movieID
+		rating.UpdatedAt = now
+
+		if _, err := tx.ExecContext(ctx, query, movieID, rating.Source, rating.Value, rating.Score, rating.Votes, now); err != nil {
+			return fmt.Errorf("failed to save movie rating: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (r *movieRepository) DeleteMovie(ctx context.Context, id uuid.UUID) error {
 	query := `DELETE FROM movies WHERE id = $1`
 
-	result, err := r.db.Exec(query, id)
+	result, err := r.db.ExecContext(ctx, query, id)
 	if err != nil {
 		return fmt.Errorf("failed to delete movie: %w", err)
 	}
@@ -134,19 +290,20 @@ func (r *movieRepository) DeleteMovie(id uuid.UUID) error {
 	return nil
 }
 
-func (r *movieRepository) GetRandomMovie() (*domain.Movie, error) {
+func (r *movieRepository) GetRandomMovie(ctx context.Context) (*domain.Movie, error) {
 	var movie domain.Movie
 	query := `
 		SELECT id, external_api_id, title, overview, release_date, poster_url,
 			   backdrop_url, genres, runtime, vote_average, vote_count, adult,
-			   cache_expires_at, created_at, updated_at
+			   languages, countries, awards, award_wins, award_nominations, box_office,
+			   provider, title_type, total_seasons, tmdb_id, last_sync_at, cache_expires_at, created_at, updated_at
 		FROM movies 
 		WHERE cache_expires_at > NOW()
 		ORDER BY RANDOM()
 		LIMIT 1
 	`
 
-	err := r.db.Get(&movie, query)
+	err := r.db.GetContext(ctx, &movie, query)
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return nil, fmt.Errorf("no movies found")
@@ -157,12 +314,13 @@ func (r *movieRepository) GetRandomMovie() (*domain.Movie, error) {
 	return &movie, nil
 }
 
-func (r *movieRepository) GetRandomMovieByGenre(genre string) (*domain.Movie, error) {
+func (r *movieRepository) GetRandomMovieByGenre(ctx context.Context, genre string) (*domain.Movie, error) {
 	var movie domain.Movie
 	query := `
 		SELECT id, external_api_id, title, overview, release_date, poster_url,
 			   backdrop_url, genres, runtime, vote_average, vote_count, adult,
-			   cache_expires_at, created_at, updated_at
+			   languages, countries, awards, award_wins, award_nominations, box_office,
+			   provider, title_type, total_seasons, tmdb_id, last_sync_at, cache_expires_at, created_at, updated_at
 		FROM movies 
 		WHERE cache_expires_at > NOW()
 		  AND $1 = ANY(genres)
@@ -170,7 +328,7 @@ func (r *movieRepository) GetRandomMovieByGenre(genre string) (*domain.Movie, er
 		LIMIT 1
 	`
 
-	err := r.db.Get(&movie, query, genre)
+	err := r.db.GetContext(ctx, &movie, query, genre)
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return nil, fmt.Errorf("no movies found for genre: %s", genre)
@@ -181,13 +339,14 @@ func (r *movieRepository) GetRandomMovieByGenre(genre string) (*domain.Movie, er
 	return &movie, nil
 }
 
-func (r *movieRepository) SearchMovies(queryText string, limit int) ([]*domain.Movie, error) {
+func (r *movieRepository) SearchMovies(ctx context.Context, queryText string, limit int) ([]*domain.Movie, error) {
 	var movies []*domain.Movie
 
 	query := `
 		SELECT id, external_api_id, title, overview, release_date, poster_url,
 			   backdrop_url, genres, runtime, vote_average, vote_count, adult,
-			   cache_expires_at, created_at, updated_at
+			   languages, countries, awards, award_wins, award_nominations, box_office,
+			   provider, title_type, total_seasons, tmdb_id, last_sync_at, cache_expires_at, created_at, updated_at
 		FROM movies 
 		WHERE cache_expires_at > NOW()
 		  AND (
@@ -199,7 +358,7 @@ func (r *movieRepository) SearchMovies(queryText string, limit int) ([]*domain.M
 	`
 
 	searchPattern := "%" + queryText + "%"
-	err := r.db.Select(&movies, query, searchPattern, limit)
+	err := r.db.SelectContext(ctx, &movies, query, searchPattern, limit)
 	if err != nil {
 		return nil, fmt.Errorf("failed to search movies: %w", err)
 	}
@@ -208,19 +367,20 @@ func (r *movieRepository) SearchMovies(queryText string, limit int) ([]*domain.M
 }
 
 // GetRandomMovies returns N random movies from the database
-func (r *movieRepository) GetRandomMovies(limit int) ([]*domain.Movie, error) {
+func (r *movieRepository) GetRandomMovies(ctx context.Context, limit int) ([]*domain.Movie, error) {
 	var movies []*domain.Movie
 
 	query := `
 		SELECT id, external_api_id, title, overview, release_date, poster_url,
 			   backdrop_url, genres, runtime, vote_average, vote_count, adult,
-			   cache_expires_at, created_at, updated_at
+			   languages, countries, awards, award_wins, award_nominations, box_office,
+			   provider, title_type, total_seasons, tmdb_id, last_sync_at, cache_expires_at, created_at, updated_at
 		FROM movies
 		ORDER BY RANDOM()
 		LIMIT $1
 	`
 
-	err := r.db.Select(&movies, query, limit)
+	err := r.db.SelectContext(ctx, &movies, query, limit)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get random movies: %w", err)
 	}
@@ -229,7 +389,7 @@ func (r *movieRepository) GetRandomMovies(limit int) ([]*domain.Movie, error) {
 }
 
 // CountMovies returns the total number of valid (non-expired) movies in the database
-func (r *movieRepository) CountMovies() (int, error) {
+func (r *movieRepository) CountMovies(ctx context.Context) (int, error) {
 	var count int
 
 	query := `
@@ -237,7 +397,7 @@ func (r *movieRepository) CountMovies() (int, error) {
 		FROM movies
 	`
 
-	err := r.db.Get(&count, query)
+	err := r.db.GetContext(ctx, &count, query)
 	if err != nil {
 		return 0, fmt.Errorf("failed to count movies: %w", err)
 	}
@@ -245,6 +405,129 @@ func (r *movieRepository) CountMovies() (int, error) {
 	return count, nil
 }
 
+func (r *movieRepository) GetExpiredMovies(ctx context.Context, limit int, excludeIDs []uuid.UUID) ([]*domain.Movie, error) {
+	movies := []*domain.Movie{}
+	if excludeIDs == nil {
+		excludeIDs = []uuid.UUID{} // a NULL array would exclude every row
+	}
+
+	query := `
+		SELECT m.id, m.external_api_id, m.title, m.overview, m.release_date, m.poster_url,
+			   m.backdrop_url, m.genres, m.runtime, m.vote_average, m.vote_count, m.adult,
+			   m.languages, m.countries, m.awards, m.award_wins, m.award_nominations, m.box_office,
+			   m.provider, m.title_type, m.total_seasons, m.tmdb_id, m.last_sync_at, m.cache_expires_at, m.created_at, m.updated_at
+		FROM movies m
+		LEFT JOIN (
+			SELECT movie_id, COUNT(*) AS total FROM watched_movies GROUP BY movie_id
+		) w ON w.movie_id = m.id
+		LEFT JOIN (
+			SELECT movie_id, COUNT(*) AS total FROM favorite_movies GROUP BY movie_id
+		) f ON f.movie_id = m.id
+		WHERE m.cache_expires_at <= NOW()
+		  AND m.provider <> 'internal'
+		  AND NOT (m.id = ANY($2))
+		ORDER BY COALESCE(w.total, 0) + COALESCE(f.total, 0) DESC, m.cache_expires_at ASC
+		LIMIT $1
+	`
+
+	err := r.db.SelectContext(ctx, &movies, query, limit, pq.Array(excludeIDs))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get expired movies: %w", err)
+	}
+
+	return movies, nil
+}
+
+func (r *movieRepository) GetMovieCredits(ctx context.Context, movieID uuid.UUID) ([]domain.MovieCredit, error) {
+	credits := []domain.MovieCredit{}
+
+	query := `
+		SELECT mc.movie_id, mc.person_id, p.name, mc.role, mc.character_name, mc.billing_order
+		FROM movie_credits mc
+		JOIN people p ON p.id = mc.person_id
+		WHERE mc.movie_id = $1
+		ORDER BY mc.role, mc.billing_order
+	`
+
+	err := r.db.SelectContext(ctx, &credits, query, movieID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get movie credits: %w", err)
+	}
+
+	return credits, nil
+}
+
+func (r *movieRepository) GetMovieRatings(ctx context.Context, movieID uuid.UUID) ([]domain.MovieRating, error) {
+	ratings := []domain.MovieRating{}
+
+	query := `
+		SELECT movie_id, source, value, score, votes, updated_at
+		FROM movie_ratings
+		WHERE movie_id = $1
+		ORDER BY source
+	`
+
+	err := r.db.SelectContext(ctx, &ratings, query, movieID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get movie ratings: %w", err)
+	}
+
+	return ratings, nil
+}
+
+func (r *movieRepository) BrowseMovies(ctx context.Context, filter domain.MovieBrowseFilter) ([]*domain.Movie, int, error) {
+	conditions := []string{"1 = 1"}
+	args := []interface{}{}
+
+	if filter.Genre != "" {
+		args = append(args, filter.Genre)
+		conditions = append(conditions, fmt.Sprintf("$%d = ANY(genres)", len(args)))
+	}
+	if filter.TitleType != "" {
+		args = append(args, filter.TitleType)
+		conditions = append(conditions, fmt.Sprintf("title_type = $%d", len(args)))
+	}
+
+	roleCondition := ""
+	if filter.Role != "" {
+		args = append(args, filter.Role)
+		roleCondition = fmt.Sprintf(" AND mc.role = $%d", len(args))
+	}
+	for _, personID := range filter.PersonIDs {
+		args = append(args, personID)
+		conditions = append(conditions, fmt.Sprintf(
+			"EXISTS (SELECT 1 FROM movie_credits mc WHERE mc.movie_id = movies.id AND mc.person_id = $%d%s)",
+			len(args), roleCondition,
+		))
+	}
+
+	where := strings.Join(conditions, " AND ")
+
+	var total int
+	if err := r.db.GetContext(ctx, &total, "SELECT COUNT(*) FROM movies WHERE "+where, args...); err != nil {
+		return nil, 0, fmt.Errorf("failed to count movies: %w", err)
+	}
+
+	args = append(args, filter.Limit, filter.Offset)
+	query := fmt.Sprintf(`
+		SELECT id, external_api_id, title, overview, release_date, poster_url,
+			   backdrop_url, genres, runtime, vote_average, vote_count, adult,
+			   languages, countries, awards, award_wins, award_nominations, box_office,
+			   provider, title_type, total_seasons, tmdb_id, last_sync_at, cache_expires_at, created_at, updated_at
+		FROM movies
+		WHERE %s
+		ORDER BY release_date DESC NULLS LAST, title
+		LIMIT $%d OFFSET $%d
+	`, where, len(args)-1, len(args))
+
+	movies := []*domain.Movie{}
+	if err := r.db.SelectContext(ctx, &movies, query, args...); err != nil {
+		return nil, 0, fmt.Errorf("failed to browse movies: %w", err)
+	}
+
+	return movies, total, nil
+}
+
 func StringSliceToArray(s []string) pq.StringArray {
 	return pq.StringArray(s)
 }
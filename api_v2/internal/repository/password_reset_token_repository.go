@@ -0,0 +1,102 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/EduardoMG12/cine/api_v2/internal/domain"
+	"github.com/google/uuid"
+)
+
+type passwordResetTokenRepository struct {
+	db *DB
+}
+
+func NewPasswordResetTokenRepository(db *DB) domain.PasswordResetTokenRepository {
+	return &passwordResetTokenRepository{db: db}
+}
+
+func (r *passwordResetTokenRepository) CreatePasswordResetToken(ctx context.Context, token *domain.PasswordResetToken) error {
+	token.ID = uuid.New()
+	token.CreatedAt = time.Now()
+
+	query := `
+		INSERT INTO password_reset_tokens (
+			id, user_id, token, expires_at, used_at, created_at
+		) VALUES (
+			:id, :user_id, :token, :expires_at, :used_at, :created_at
+		)
+	`
+
+	_, err := r.db.NamedExecContext(ctx, query, token)
+	if err != nil {
+		return fmt.Errorf("failed to create password reset token: %w", err)
+	}
+
+	return nil
+}
+
+func (r *passwordResetTokenRepository) GetPasswordResetTokenByHash(ctx context.Context, tokenHash string) (*domain.PasswordResetToken, error) {
+	var token domain.PasswordResetToken
+	query := `
+		SELECT id, user_id, token, expires_at, used_at, created_at
+		FROM password_reset_tokens
+		WHERE token = $1
+	`
+
+	err := r.db.GetContext(ctx, &token, query, tokenHash)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("password reset token not found")
+		}
+		return nil, fmt.Errorf("failed to get password reset token: %w", err)
+	}
+
+	return &token, nil
+}
+
+func (r *passwordResetTokenRepository) GetUserPasswordResetTokensSince(ctx context.Context, userID uuid.UUID, since time.Time) ([]domain.PasswordResetToken, error) {
+	var tokens []domain.PasswordResetToken
+	query := `
+		SELECT id, user_id, token, expires_at, used_at, created_at
+		FROM password_reset_tokens
+		WHERE user_id = $1 AND created_at > $2
+		ORDER BY created_at DESC
+	`
+
+	err := r.db.SelectContext(ctx, &tokens, query, userID, since)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get password reset tokens: %w", err)
+	}
+
+	return tokens, nil
+}
+
+func (r *passwordResetTokenRepository) MarkPasswordResetTokenUsed(ctx context.Context, id uuid.UUID) (bool, error) {
+	query := `UPDATE password_reset_tokens SET used_at = NOW() WHERE id = $1 AND used_at IS NULL`
+
+	result, err := r.db.ExecContext(ctx, query, id)
+	if err != nil {
+		return false, fmt.Errorf("failed to mark password reset token as used: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	return rowsAffected == 1, nil
+}
+
+func (r *passwordResetTokenRepository) DeleteUserPasswordResetTokens(ctx context.Context, userID uuid.UUID) error {
+	query := `DELETE FROM password_reset_tokens WHERE user_id = $1`
+
+	_, err := r.db.ExecContext(ctx, query, userID)
+	if err != nil {
+		return fmt.Errorf("failed to delete password reset tokens: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,84 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/EduardoMG12/cine/api_v2/internal/domain"
+	"github.com/google/uuid"
+)
+
+type personRepository struct {
+	db *DB
+}
+
+func NewPersonRepository(db *DB) domain.PersonRepository {
+	return &personRepository{db: db}
+}
+
+func (r *personRepository) GetPersonByID(ctx context.Context, id uuid.UUID) (*domain.Person, error) {
+	var person domain.Person
+	query := `SELECT id, name, created_at, updated_at FROM people WHERE id = $1`
+
+	err := r.db.GetContext(ctx, &person, query, id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("person not found")
+		}
+		return nil, fmt.Errorf("failed to get person by id: %w", err)
+	}
+
+	return &person, nil
+}
+
+func (r *personRepository) SearchPeople(ctx context.Context, query string, limit, offset int) ([]domain.PersonSearchResult, int, error) {
+	pattern := "%" + escapeLikePattern(query) + "%"
+	where := `(LOWER(name) LIKE $2 OR LOWER(name) % $1)`
+
+	var total int
+	if err := r.db.GetContext(ctx, &total, "SELECT COUNT(*) FROM people WHERE "+where, query, pattern); err != nil {
+		return nil, 0, fmt.Errorf("failed to count people: %w", err)
+	}
+
+	searchQuery := `
+		SELECT p.id, p.name, p.created_at, p.updated_at,
+			   (SELECT COUNT(DISTINCT mc.movie_id) FROM movie_credits mc WHERE mc.person_id = p.id) AS movie_count
+		FROM people p
+		WHERE ` + where + `
+		ORDER BY
+			LOWER(name) = $1 DESC,
+			starts_with(LOWER(name), $1) DESC,
+			similarity(LOWER(name), $1) DESC,
+			movie_count DESC,
+			name
+		LIMIT $3 OFFSET $4
+	`
+
+	people := []domain.PersonSearchResult{}
+	if err := r.db.SelectContext(ctx, &people, searchQuery, query, pattern, limit, offset); err != nil {
+		return nil, 0, fmt.Errorf("failed to search people: %w", err)
+	}
+
+	return people, total, nil
+}
+
+func (r *personRepository) GetPersonFilmography(ctx context.Context, personID uuid.UUID) ([]domain.PersonCredit, error) {
+	credits := []domain.PersonCredit{}
+
+	query := `
+		SELECT m.id AS movie_id, m.external_api_id, m.title, m.release_date, m.poster_url,
+			   mc.role, mc.character_name, mc.billing_order
+		FROM movie_credits mc
+		JOIN movies m ON m.id = mc.movie_id
+		WHERE mc.person_id = $1
+		ORDER BY m.release_date DESC NULLS LAST, m.title, mc.role
+	`
+
+	err := r.db.SelectContext(ctx, &credits, query, personID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get person filmography: %w", err)
+	}
+
+	return credits, nil
+}
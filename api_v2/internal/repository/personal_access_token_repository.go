@@ -0,0 +1,113 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/EduardoMG12/cine/api_v2/internal/domain"
+	"github.com/google/uuid"
+)
+
+type personalAccessTokenRepository struct {
+	db *DB
+}
+
+func NewPersonalAccessTokenRepository(db *DB) domain.PersonalAccessTokenRepository {
+	return &personalAccessTokenRepository{db: db}
+}
+
+func (r *personalAccessTokenRepository) CreatePersonalAccessToken(ctx context.Context, token *domain.PersonalAccessToken) error {
+	token.ID = uuid.New()
+	token.CreatedAt = time.Now()
+
+	query := `
+		INSERT INTO personal_access_tokens (
+			id, user_id, name, token_hash, token_prefix, scopes, expires_at, created_at
+		) VALUES (
+			:id, :user_id, :name, :token_hash, :token_prefix, :scopes, :expires_at, :created_at
+		)
+	`
+
+	_, err := r.db.NamedExecContext(ctx, query, token)
+	if err != nil {
+		return fmt.Errorf("failed to create personal access token: %w", err)
+	}
+
+	return nil
+}
+
+func (r *personalAccessTokenRepository) GetPersonalAccessTokenByHash(ctx context.Context, tokenHash string) (*domain.PersonalAccessToken, error) {
+	var token domain.PersonalAccessToken
+	query := `
+		SELECT id, user_id, name, token_hash, token_prefix, scopes, expires_at, last_used_at, created_at
+		FROM personal_access_tokens
+		WHERE token_hash = $1
+	`
+
+	err := r.db.GetContext(ctx, &token, query, tokenHash)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("personal access token not found")
+		}
+		return nil, fmt.Errorf("failed to get personal access token: %w", err)
+	}
+
+	return &token, nil
+}
+
+func (r *personalAccessTokenRepository) GetUserPersonalAccessTokens(ctx context.Context, userID uuid.UUID) ([]domain.PersonalAccessToken, error) {
+	var tokens []domain.PersonalAccessToken
+	query := `
+		SELECT id, user_id, name, token_hash, token_prefix, scopes, expires_at, last_used_at, created_at
+		FROM personal_access_tokens
+		WHERE user_id = $1
+		ORDER BY created_at DESC
+	`
+
+	err := r.db.SelectContext(ctx, &tokens, query, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get personal access tokens: %w", err)
+	}
+
+	return tokens, nil
+}
+
+func (r *personalAccessTokenRepository) TouchPersonalAccessToken(ctx context.Context, id uuid.UUID) error {
+	query := `UPDATE personal_access_tokens SET last_used_at = NOW() WHERE id = $1`
+
+	_, err := r.db.ExecContext(ctx, query, id)
+	if err != nil {
+		return fmt.Errorf("failed to update personal access token: %w", err)
+	}
+
+	return nil
+}
+
+func (r *personalAccessTokenRepository) DeletePersonalAccessToken(ctx context.Context, id, userID uuid.UUID) (bool, error) {
+	query := `DELETE FROM personal_access_tokens WHERE id = $1 AND user_id = $2`
+
+	result, err := r.db.ExecContext(ctx, query, id, userID)
+	if err != nil {
+		return false, fmt.Errorf("failed to delete personal access token: %w", err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to delete personal access token: %w", err)
+	}
+
+	return affected > 0, nil
+}
+
+func (r *personalAccessTokenRepository) DeleteUserPersonalAccessTokens(ctx context.Context, userID uuid.UUID) error {
+	query := `DELETE FROM personal_access_tokens WHERE user_id = $1`
+
+	_, err := r.db.ExecContext(ctx, query, userID)
+	if err != nil {
+		return fmt.Errorf("failed to delete personal access tokens: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,172 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/EduardoMG12/cine/api_v2/internal/domain"
+	"github.com/google/uuid"
+)
+
+type seriesRepository struct {
+	db *DB
+}
+
+func NewSeriesRepository(db *DB) domain.SeriesRepository {
+	return &seriesRepository{db: db}
+}
+
+func (r *seriesRepository) GetSeasons(ctx context.Context, seriesID uuid.UUID) ([]domain.Season, error) {
+	seasons := []domain.Season{}
+
+	query := `
+		SELECT series_id, season_number, episode_count, last_sync_at, cache_expires_at
+		FROM series_seasons
+		WHERE series_id = $1
+		ORDER BY season_number
+	`
+
+	err := r.db.SelectContext(ctx, &seasons, query, seriesID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get seasons: %w", err)
+	}
+
+	return seasons, nil
+}
+
+func (r *seriesRepository) GetSeason(ctx context.Context, seriesID uuid.UUID, seasonNumber int) (*domain.Season, error) {
+	var season domain.Season
+
+	query := `
+		SELECT series_id, season_number, episode_count, last_sync_at, cache_expires_at
+		FROM series_seasons
+		WHERE series_id = $1 AND season_number = $2
+	`
+
+	err := r.db.GetContext(ctx, &season, query, seriesID, seasonNumber)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("season not found")
+		}
+		return nil, fmt.Errorf("failed to get season: %w", err)
+	}
+
+	return &season, nil
+}
+
+func (r *seriesRepository) SaveSeason(ctx context.Context, season *domain.Season, episodes []domain.Episode) error {
+	ctx, cancel := r.db.withTimeout(ctx)
+	defer cancel()
+
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	seasonQuery := `
+		INSERT INTO series_seasons (series_id, season_number, episode_count, last_sync_at, cache_expires_at)
+		VALUES (:series_id, :season_number, :episode_count, :last_sync_at, :cache_expires_at)
+		ON CONFLICT (series_id, season_number) DO UPDATE SET
+			episode_count = EXCLUDED.episode_count,
+			last_sync_at = EXCLUDED.last_sync_at,
+			cache_expires_at = EXCLUDED.cache_expires_at
+	`
+	if _, err := tx.NamedExecContext(ctx, seasonQuery, season); err != nil {
+		return fmt.Errorf("failed to save season: %w", err)
+	}
+
+	// Episodes are matched by number so their IDs, and the watched episodes, survive a sync
+	episodeQuery := `
+		INSERT INTO episodes (
+			id, series_id, season_number, episode_number, external_api_id,
+			title, release_date, imdb_rating, created_at, updated_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, NOW(), NOW())
+		ON CONFLICT (series_id, season_number, episode_number) DO UPDATE SET
+			external_api_id = EXCLUDED.external_api_id,
+			title = EXCLUDED.title,
+			release_date = EXCLUDED.release_date,
+			imdb_rating = EXCLUDED.imdb_rating,
+			updated_at = NOW()
+		RETURNING id, created_at, updated_at
+	`
+	for i := range episodes {
+		episode := &episodes[i]
+		episode.SeriesID = season.SeriesID
+		episode.SeasonNumber = season.SeasonNumber
+
+		err := tx.GetContext(ctx, episode, episodeQuery,
+			uuid.New(), episode.SeriesID, episode.SeasonNumber, episode.EpisodeNumber, episode.ExternalAPIID,
+			episode.Title, episode.ReleaseDate, episode.IMDbRating,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to save episode: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit season: %w", err)
+	}
+
+	return nil
+}
+
+func (r *seriesRepository) GetSeasonEpisodes(ctx context.Context, seriesID uuid.UUID, seasonNumber int) ([]domain.Episode, error) {
+	episodes := []domain.Episode{}
+
+	query := `
+		SELECT id, series_id, season_number, episode_number, external_api_id,
+			   title, release_date, imdb_rating, created_at, updated_at
+		FROM episodes
+		WHERE series_id = $1 AND season_number = $2
+		ORDER BY episode_number
+	`
+
+	err := r.db.SelectContext(ctx, &episodes, query, seriesID, seasonNumber)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get season episodes: %w", err)
+	}
+
+	return episodes, nil
+}
+
+func (r *seriesRepository) GetSeriesEpisodes(ctx context.Context, seriesID uuid.UUID) ([]domain.Episode, error) {
+	episodes := []domain.Episode{}
+
+	query := `
+		SELECT id, series_id, season_number, episode_number, external_api_id,
+			   title, release_date, imdb_rating, created_at, updated_at
+		FROM episodes
+		WHERE series_id = $1
+		ORDER BY season_number, episode_number
+	`
+
+	err := r.db.SelectContext(ctx, &episodes, query, seriesID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get series episodes: %w", err)
+	}
+
+	return episodes, nil
+}
+
+func (r *seriesRepository) GetEpisodeByID(ctx context.Context, id uuid.UUID) (*domain.Episode, error) {
+	var episode domain.Episode
+
+	query := `
+		SELECT id, series_id, season_number, episode_number, external_api_id,
+			   title, release_date, imdb_rating, created_at, updated_at
+		FROM episodes
+		WHERE id = $1
+	`
+
+	err := r.db.GetContext(ctx, &episode, query, id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("episode not found")
+		}
+		return nil, fmt.Errorf("failed to get episode by id: %w", err)
+	}
+
+	return &episode, nil
+}
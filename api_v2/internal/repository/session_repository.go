@@ -1,36 +1,37 @@
 package repository
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"time"
 
 	"github.com/EduardoMG12/cine/api_v2/internal/domain"
 	"github.com/google/uuid"
-	"github.com/jmoiron/sqlx"
 )
 
 type sessionRepository struct {
-	db *sqlx.DB
+	db *DB
 }
 
-func NewSessionRepository(db *sqlx.DB) domain.SessionRepository {
+func NewSessionRepository(db *DB) domain.SessionRepository {
 	return &sessionRepository{db: db}
 }
 
-func (r *sessionRepository) CreateSession(session *domain.UserSession) error {
+func (r *sessionRepository) CreateSession(ctx context.Context, session *domain.UserSession) error {
 	session.ID = uuid.New()
 	session.CreatedAt = time.Now()
+	session.LastUsedAt = &session.CreatedAt
 
 	query := `
 		INSERT INTO user_sessions (
-			id, user_id, token, expires_at, created_at, user_agent, ip_address
+			id, user_id, token, expires_at, created_at, last_used_at, user_agent, ip_address
 		) VALUES (
-			:id, :user_id, :token, :expires_at, :created_at, :user_agent, :ip_address
+			:id, :user_id, :token, :expires_at, :created_at, :last_used_at, :user_agent, :ip_address
 		)
 	`
 
-	_, err := r.db.NamedExec(query, session)
+	_, err := r.db.NamedExecContext(ctx, query, session)
 	if err != nil {
 		return fmt.Errorf("failed to create session: %w", err)
 	}
@@ -38,15 +39,15 @@ func (r *sessionRepository) CreateSession(session *domain.UserSession) error {
 	return nil
 }
 
-func (r *sessionRepository) GetSessionByToken(token string) (*domain.UserSession, error) {
+func (r *sessionRepository) GetSessionByToken(ctx context.Context, token string) (*domain.UserSession, error) {
 	var session domain.UserSession
 	query := `
-		SELECT id, user_id, token, expires_at, created_at, user_agent, ip_address
+		SELECT id, user_id, token, expires_at, created_at, last_used_at, user_agent, ip_address
 		FROM user_sessions 
 		WHERE token = $1 AND expires_at > NOW()
 	`
 
-	err := r.db.Get(&session, query, token)
+	err := r.db.GetContext(ctx, &session, query, token)
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return nil, fmt.Errorf("session not found or expired")
@@ -57,10 +58,83 @@ func (r *sessionRepository) GetSessionByToken(token string) (*domain.UserSession
 	return &session, nil
 }
 
-func (r *sessionRepository) DeleteSession(token string) error {
+func (r *sessionRepository) GetSessionByID(ctx context.Context, id uuid.UUID) (*domain.UserSession, error) {
+	var session domain.UserSession
+	query := `
+		SELECT id, user_id, token, expires_at, created_at, last_used_at, user_agent, ip_address
+		FROM user_sessions
+		WHERE id = $1 AND expires_at > NOW()
+	`
+
+	err := r.db.GetContext(ctx, &session, query, id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("session not found or expired")
+		}
+		return nil, fmt.Errorf("failed to get session by id: %w", err)
+	}
+
+	return &session, nil
+}
+
+func (r *sessionRepository) GetUserSessions(ctx context.Context, userID uuid.UUID) ([]domain.UserSession, error) {
+	var sessions []domain.UserSession
+	query := `
+		SELECT id, user_id, token, expires_at, created_at, last_used_at, user_agent, ip_address
+		FROM user_sessions
+		WHERE user_id = $1 AND expires_at > NOW()
+		ORDER BY last_used_at DESC NULLS LAST, created_at DESC
+	`
+
+	err := r.db.SelectContext(ctx, &sessions, query, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user sessions: %w", err)
+	}
+
+	return sessions, nil
+}
+
+func (r *sessionRepository) UpdateSession(ctx context.Context, session *domain.UserSession) error {
+	query := `
+		UPDATE user_sessions SET
+			token = :token,
+			expires_at = :expires_at,
+			last_used_at = NOW()
+		WHERE id = :id
+	`
+
+	result, err := r.db.NamedExecContext(ctx, query, session)
+	if err != nil {
+		return fmt.Errorf("failed to update session: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("session not found")
+	}
+
+	return nil
+}
+
+func (r *sessionRepository) TouchSession(ctx context.Context, id uuid.UUID) error {
+	query := `UPDATE user_sessions SET last_used_at = NOW() WHERE id = $1`
+
+	_, err := r.db.ExecContext(ctx, query, id)
+	if err != nil {
+		return fmt.Errorf("failed to touch session: %w", err)
+	}
+
+	return nil
+}
+
+func (r *sessionRepository) DeleteSession(ctx context.Context, token string) error {
 	query := `DELETE FROM user_sessions WHERE token = $1`
 
-	result, err := r.db.Exec(query, token)
+	result, err := r.db.ExecContext(ctx, query, token)
 	if err != nil {
 		return fmt.Errorf("failed to delete session: %w", err)
 	}
@@ -77,13 +151,44 @@ func (r *sessionRepository) DeleteSession(token string) error {
 	return nil
 }
 
-func (r *sessionRepository) DeleteUserSessions(userID uuid.UUID) error {
+func (r *sessionRepository) DeleteUserSessions(ctx context.Context, userID uuid.UUID) error {
 	query := `DELETE FROM user_sessions WHERE user_id = $1`
 
-	_, err := r.db.Exec(query, userID)
+	_, err := r.db.ExecContext(ctx, query, userID)
+	if err != nil {
+		return fmt.Errorf("failed to delete user sessions: %w", err)
+	}
+
+	return nil
+}
+
+func (r *sessionRepository) DeleteUserSessionsExcept(ctx context.Context, userID, keepSessionID uuid.UUID) error {
+	query := `DELETE FROM user_sessions WHERE user_id = $1 AND id <> $2`
+
+	_, err := r.db.ExecContext(ctx, query, userID, keepSessionID)
 	if err != nil {
 		return fmt.Errorf("failed to delete user sessions: %w", err)
 	}
 
 	return nil
 }
+
+func (r *sessionRepository) DeleteSessionByID(ctx context.Context, id uuid.UUID) error {
+	query := `DELETE FROM user_sessions WHERE id = $1`
+
+	result, err := r.db.ExecContext(ctx, query, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete session: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("session not found")
+	}
+
+	return nil
+}
@@ -0,0 +1,103 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/EduardoMG12/cine/api_v2/internal/domain"
+	"github.com/google/uuid"
+)
+
+type totpRepository struct {
+	db *DB
+}
+
+func NewTOTPRepository(db *DB) domain.TOTPRepository {
+	return &totpRepository{db: db}
+}
+
+func (r *totpRepository) GetUserTOTP(ctx context.Context, userID uuid.UUID) (*domain.UserTOTP, error) {
+	var totp domain.UserTOTP
+	query := `
+		SELECT user_id, secret, enabled_at, last_used_step, created_at
+		FROM user_totp
+		WHERE user_id = $1
+	`
+
+	err := r.db.GetContext(ctx, &totp, query, userID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("totp not found")
+		}
+		return nil, fmt.Errorf("failed to get totp: %w", err)
+	}
+
+	return &totp, nil
+}
+
+func (r *totpRepository) SaveUserTOTP(ctx context.Context, totp *domain.UserTOTP) error {
+	totp.CreatedAt = time.Now()
+	totp.EnabledAt = nil
+	totp.LastUsedStep = nil
+
+	query := `
+		INSERT INTO user_totp (user_id, secret, enabled_at, last_used_step, created_at)
+		VALUES (:user_id, :secret, :enabled_at, :last_used_step, :created_at)
+		ON CONFLICT (user_id) DO UPDATE SET
+			secret = EXCLUDED.secret,
+			enabled_at = NULL,
+			last_used_step = NULL,
+			created_at = EXCLUDED.created_at
+		WHERE user_totp.enabled_at IS NULL
+	`
+
+	_, err := r.db.NamedExecContext(ctx, query, totp)
+	if err != nil {
+		return fmt.Errorf("failed to save totp: %w", err)
+	}
+
+	return nil
+}
+
+func (r *totpRepository) EnableUserTOTP(ctx context.Context, userID uuid.UUID) error {
+	query := `UPDATE user_totp SET enabled_at = NOW() WHERE user_id = $1`
+
+	_, err := r.db.ExecContext(ctx, query, userID)
+	if err != nil {
+		return fmt.Errorf("failed to enable totp: %w", err)
+	}
+
+	return nil
+}
+
+func (r *totpRepository) UseTOTPStep(ctx context.Context, userID uuid.UUID, step int64) (bool, error) {
+	query := `
+		UPDATE user_totp SET last_used_step = $2
+		WHERE user_id = $1 AND (last_used_step IS NULL OR last_used_step < $2)
+	`
+
+	result, err := r.db.ExecContext(ctx, query, userID, step)
+	if err != nil {
+		return false, fmt.Errorf("failed to record totp step: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	return rowsAffected == 1, nil
+}
+
+func (r *totpRepository) DeleteUserTOTP(ctx context.Context, userID uuid.UUID) error {
+	query := `DELETE FROM user_totp WHERE user_id = $1`
+
+	_, err := r.db.ExecContext(ctx, query, userID)
+	if err != nil {
+		return fmt.Errorf("failed to delete totp: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,102 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/EduardoMG12/cine/api_v2/internal/domain"
+	"github.com/google/uuid"
+)
+
+// exportedTable describes how to find the rows of a user in a table
+type exportedTable struct {
+	name     string
+	where    string
+	excluded []string
+}
+
+// Every table holding user-owned rows. Keep in sync with the migrations.
+var exportedTables = []exportedTable{
+	{name: "users", where: "id = $1", excluded: []string{"password_hash"}},
+	{name: "username_reservations", where: "user_id = $1"},
+	{name: "user_sessions", where: "user_id = $1", excluded: []string{"token"}},
+	{name: "user_totp", where: "user_id = $1", excluded: []string{"secret", "last_used_step"}},
+	{name: "mfa_recovery_codes", where: "user_id = $1", excluded: []string{"code_hash"}},
+	{name: "email_change_tokens", where: "user_id = $1", excluded: []string{"token_hash"}},
+	{name: "personal_access_tokens", where: "user_id = $1", excluded: []string{"token_hash"}},
+	{name: "login_lockouts", where: "scope = 'email' AND identifier = (SELECT LOWER(email) FROM users WHERE id = $1)"},
+	{name: "watched_movies", where: "user_id = $1"},
+	{name: "watched_episodes", where: "user_id = $1"},
+	{name: "favorite_movies", where: "user_id = $1"},
+	{name: "reviews", where: "user_id = $1"},
+	{name: "movie_lists", where: "user_id = $1"},
+	{name: "movie_list_entries", where: "movie_list_id IN (SELECT id FROM movie_lists WHERE user_id = $1)"},
+	{name: "posts", where: "user_id = $1"},
+	{name: "friendships", where: "user_id_1 = $1 OR user_id_2 = $1"},
+	{name: "follows", where: "follower_id = $1 OR following_id = $1"},
+	{name: "match_sessions", where: "host_user_id = $1"},
+	{name: "match_session_participants", where: "user_id = $1"},
+	{name: "match_interactions", where: "user_id = $1"},
+}
+
+type userDataExportRepository struct {
+	db *DB
+}
+
+func NewUserDataExportRepository(db *DB) domain.UserDataExportRepository {
+	return &userDataExportRepository{db: db}
+}
+
+func (r *userDataExportRepository) ExportUserData(ctx context.Context, userID uuid.UUID) (map[string][]map[string]interface{}, error) {
+	data := make(map[string][]map[string]interface{}, len(exportedTables))
+
+	for _, table := range exportedTables {
+		rows, err := r.exportTable(ctx, table, userID)
+		if err != nil {
+			return nil, err
+		}
+		data[table.name] = rows
+	}
+
+	return data, nil
+}
+
+func (r *userDataExportRepository) exportTable(ctx context.Context, table exportedTable, userID uuid.UUID) ([]map[string]interface{}, error) {
+	query := fmt.Sprintf("SELECT * FROM %s WHERE %s", table.name, table.where)
+
+	ctx, cancel := r.db.withTimeout(ctx)
+	defer cancel()
+
+	rows, err := r.db.QueryxContext(ctx, query, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to export %s: %w", table.name, err)
+	}
+	defer rows.Close()
+
+	result := []map[string]interface{}{}
+	for rows.Next() {
+		row := map[string]interface{}{}
+		if err := rows.MapScan(row); err != nil {
+			return nil, fmt.Errorf("failed to export %s: %w", table.name, err)
+		}
+
+		for _, column := range table.excluded {
+			delete(row, column)
+		}
+
+		// lib/pq returns UUID, INET, NUMERIC and array columns as raw bytes
+		for column, value := range row {
+			if raw, ok := value.([]byte); ok {
+				row[column] = string(raw)
+			}
+		}
+
+		result = append(result, row)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to export %s: %w", table.name, err)
+	}
+
+	return result, nil
+}
@@ -1,39 +1,43 @@
 package repository
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/EduardoMG12/cine/api_v2/internal/domain"
 	"github.com/google/uuid"
-	"github.com/jmoiron/sqlx"
 )
 
 type userRepository struct {
-	db *sqlx.DB
+	db *DB
 }
 
-func NewUserRepository(db *sqlx.DB) domain.UserRepository {
+func NewUserRepository(db *DB) domain.UserRepository {
 	return &userRepository{db: db}
 }
 
-func (r *userRepository) CreateUser(user *domain.User) error {
+func (r *userRepository) CreateUser(ctx context.Context, user *domain.User) error {
 	user.ID = uuid.New()
 	user.CreatedAt = time.Now()
 	user.UpdatedAt = time.Now()
+	if user.Role == "" {
+		user.Role = domain.RoleUser
+	}
 
 	query := `
 		INSERT INTO users (
 			id, username, email, display_name, bio, profile_picture_url, 
-			password_hash, is_private, email_verified, theme, created_at, updated_at
+			password_hash, is_private, email_verified, theme, role, created_at, updated_at
 		) VALUES (
 			:id, :username, :email, :display_name, :bio, :profile_picture_url,
-			:password_hash, :is_private, :email_verified, :theme, :created_at, :updated_at
+			:password_hash, :is_private, :email_verified, :theme, :role, :created_at, :updated_at
 		)
 	`
 
-	_, err := r.db.NamedExec(query, user)
+	_, err := r.db.NamedExecContext(ctx, query, user)
 	if err != nil {
 		return fmt.Errorf("failed to create user: %w", err)
 	}
@@ -41,16 +45,16 @@ func (r *userRepository) CreateUser(user *domain.User) error {
 	return nil
 }
 
-func (r *userRepository) GetUserByID(id uuid.UUID) (*domain.User, error) {
+func (r *userRepository) GetUserByID(ctx context.Context, id uuid.UUID) (*domain.User, error) {
 	var user domain.User
 	query := `
 		SELECT id, username, email, display_name, bio, profile_picture_url, 
-			   password_hash, is_private, email_verified, theme, created_at, updated_at
+			   password_hash, is_private, email_verified, theme, role, disabled_at, username_changed_at, deletion_scheduled_at, created_at, updated_at
 		FROM users 
 		WHERE id = $1
 	`
 
-	err := r.db.Get(&user, query, id)
+	err := r.db.GetContext(ctx, &user, query, id)
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return nil, fmt.Errorf("user not found")
@@ -61,16 +65,16 @@ func (r *userRepository) GetUserByID(id uuid.UUID) (*domain.User, error) {
 	return &user, nil
 }
 
-func (r *userRepository) GetUserByEmail(email string) (*domain.User, error) {
+func (r *userRepository) GetUserByEmail(ctx context.Context, email string) (*domain.User, error) {
 	var user domain.User
 	query := `
 		SELECT id, username, email, display_name, bio, profile_picture_url, 
-			   password_hash, is_private, email_verified, theme, created_at, updated_at
+			   password_hash, is_private, email_verified, theme, role, disabled_at, username_changed_at, deletion_scheduled_at, created_at, updated_at
 		FROM users 
 		WHERE email = $1
 	`
 
-	err := r.db.Get(&user, query, email)
+	err := r.db.GetContext(ctx, &user, query, email)
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return nil, fmt.Errorf("user not found")
@@ -81,16 +85,16 @@ func (r *userRepository) GetUserByEmail(email string) (*domain.User, error) {
 	return &user, nil
 }
 
-func (r *userRepository) GetUserByUsername(username string) (*domain.User, error) {
+func (r *userRepository) GetUserByUsername(ctx context.Context, username string) (*domain.User, error) {
 	var user domain.User
 	query := `
 		SELECT id, username, email, display_name, bio, profile_picture_url, 
-			   password_hash, is_private, email_verified, theme, created_at, updated_at
+			   password_hash, is_private, email_verified, theme, role, disabled_at, username_changed_at, deletion_scheduled_at, created_at, updated_at
 		FROM users 
 		WHERE username = $1
 	`
 
-	err := r.db.Get(&user, query, username)
+	err := r.db.GetContext(ctx, &user, query, username)
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return nil, fmt.Errorf("user not found")
@@ -101,7 +105,7 @@ func (r *userRepository) GetUserByUsername(username string) (*domain.User, error
 	return &user, nil
 }
 
-func (r *userRepository) UpdateUser(user *domain.User) error {
+func (r *userRepository) UpdateUser(ctx context.Context, user *domain.User) error {
 	user.UpdatedAt = time.Now()
 
 	query := `
@@ -118,7 +122,7 @@ func (r *userRepository) UpdateUser(user *domain.User) error {
 		WHERE id = :id
 	`
 
-	result, err := r.db.NamedExec(query, user)
+	result, err := r.db.NamedExecContext(ctx, query, user)
 	if err != nil {
 		return fmt.Errorf("failed to update user: %w", err)
 	}
@@ -135,14 +139,202 @@ func (r *userRepository) UpdateUser(user *domain.User) error {
 	return nil
 }
 
-func (r *userRepository) DeleteUser(id uuid.UUID) error {
-	query := `DELETE FROM users WHERE id = $1`
+func (r *userRepository) UpdatePassword(ctx context.Context, id uuid.UUID, passwordHash string) error {
+	query := `UPDATE users SET password_hash = $1, updated_at = NOW() WHERE id = $2`
+
+	result, err := r.db.ExecContext(ctx, query, passwordHash, id)
+	if err != nil {
+		return fmt.Errorf("failed to update password: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("user not found")
+	}
+
+	return nil
+}
+
+func (r *userRepository) ScheduleUserDeletion(ctx context.Context, id uuid.UUID, at time.Time) error {
+	query := `UPDATE users SET deletion_scheduled_at = $1, updated_at = NOW() WHERE id = $2`
+
+	result, err := r.db.ExecContext(ctx, query, at, id)
+	if err != nil {
+		return fmt.Errorf("failed to schedule user deletion: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("user not found")
+	}
+
+	return nil
+}
+
+func (r *userRepository) CancelUserDeletion(ctx context.Context, id uuid.UUID) error {
+	query := `UPDATE users SET deletion_scheduled_at = NULL, updated_at = NOW() WHERE id = $1`
+
+	_, err := r.db.ExecContext(ctx, query, id)
+	if err != nil {
+		return fmt.Errorf("failed to cancel user deletion: %w", err)
+	}
+
+	return nil
+}
 
-	result, err := r.db.Exec(query, id)
+func (r *userRepository) PurgeScheduledUsers(ctx context.Context, now time.Time) (int64, error) {
+	purged, err := r.deleteUsers(ctx, "deletion_scheduled_at IS NOT NULL AND deletion_scheduled_at <= $1", now)
+	if err != nil {
+		return 0, fmt.Errorf("failed to purge scheduled users: %w", err)
+	}
+
+	return purged, nil
+}
+
+func (r *userRepository) DeleteUser(ctx context.Context, id uuid.UUID) error {
+	deleted, err := r.deleteUsers(ctx, "id = $1", id)
 	if err != nil {
 		return fmt.Errorf("failed to delete user: %w", err)
 	}
 
+	if deleted == 0 {
+		return fmt.Errorf("user not found")
+	}
+
+	return nil
+}
+
+// deleteUsers hard deletes the users matching condition. Their other rows go with them through
+// ON DELETE CASCADE; login lockouts are kept by email, without a user, so they are deleted here.
+func (r *userRepository) deleteUsers(ctx context.Context, condition string, args ...interface{}) (int64, error) {
+	ctx, cancel := r.db.withTimeout(ctx)
+	defer cancel()
+
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	lockoutsQuery := fmt.Sprintf(`
+		DELETE FROM login_lockouts
+		WHERE scope = 'email' AND identifier IN (SELECT LOWER(email) FROM users WHERE %s)`, condition)
+	if _, err := tx.ExecContext(ctx, lockoutsQuery, args...); err != nil {
+		return 0, fmt.Errorf("failed to delete login lockouts: %w", err)
+	}
+
+	result, err := tx.ExecContext(ctx, fmt.Sprintf(`DELETE FROM users WHERE %s`, condition), args...)
+	if err != nil {
+		return 0, err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return rowsAffected, nil
+}
+
+func (r *userRepository) ListUsers(ctx context.Context, filter domain.UserListFilter) ([]domain.User, int, error) {
+	conditions := []string{"1 = 1"}
+	args := []interface{}{}
+
+	if filter.Query != "" {
+		args = append(args, "%"+escapeLikePattern(filter.Query)+"%")
+		conditions = append(conditions, fmt.Sprintf(
+			"(username ILIKE $%d OR email ILIKE $%d OR display_name ILIKE $%d)",
+			len(args), len(args), len(args),
+		))
+	}
+	if filter.Role != "" {
+		args = append(args, filter.Role)
+		conditions = append(conditions, fmt.Sprintf("role = $%d", len(args)))
+	}
+	if filter.Disabled != nil {
+		if *filter.Disabled {
+			conditions = append(conditions, "disabled_at IS NOT NULL")
+		} else {
+			conditions = append(conditions, "disabled_at IS NULL")
+		}
+	}
+
+	where := strings.Join(conditions, " AND ")
+
+	var total int
+	if err := r.db.GetContext(ctx, &total, "SELECT COUNT(*) FROM users WHERE "+where, args...); err != nil {
+		return nil, 0, fmt.Errorf("failed to count users: %w", err)
+	}
+
+	args = append(args, filter.Limit, filter.Offset)
+	query := fmt.Sprintf(`
+		SELECT id, username, email, display_name, bio, profile_picture_url,
+			   password_hash, is_private, email_verified, theme, role, disabled_at, username_changed_at, deletion_scheduled_at, created_at, updated_at
+		FROM users
+		WHERE %s
+		ORDER BY created_at DESC
+		LIMIT $%d OFFSET $%d
+	`, where, len(args)-1, len(args))
+
+	var users []domain.User
+	if err := r.db.SelectContext(ctx, &users, query, args...); err != nil {
+		return nil, 0, fmt.Errorf("failed to list users: %w", err)
+	}
+
+	return users, total, nil
+}
+
+func (r *userRepository) CountUsersByRole(ctx context.Context, role string) (int, error) {
+	var count int
+	query := `SELECT COUNT(*) FROM users WHERE role = $1`
+
+	if err := r.db.GetContext(ctx, &count, query, role); err != nil {
+		return 0, fmt.Errorf("failed to count users by role: %w", err)
+	}
+
+	return count, nil
+}
+
+func (r *userRepository) UpdateUserRole(ctx context.Context, id uuid.UUID, role string) error {
+	query := `UPDATE users SET role = $1, updated_at = NOW() WHERE id = $2`
+
+	result, err := r.db.ExecContext(ctx, query, role, id)
+	if err != nil {
+		return fmt.Errorf("failed to update user role: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("user not found")
+	}
+
+	return nil
+}
+
+func (r *userRepository) SetUserDisabled(ctx context.Context, id uuid.UUID, at *time.Time) error {
+	query := `UPDATE users SET disabled_at = $1, updated_at = NOW() WHERE id = $2`
+
+	result, err := r.db.ExecContext(ctx, query, at, id)
+	if err != nil {
+		return fmt.Errorf("failed to update user status: %w", err)
+	}
+
 	rowsAffected, err := result.RowsAffected()
 	if err != nil {
 		return fmt.Errorf("failed to get rows affected: %w", err)
@@ -154,3 +346,148 @@ func (r *userRepository) DeleteUser(id uuid.UUID) error {
 
 	return nil
 }
+
+func (r *userRepository) SearchUsers(ctx context.Context, query string, limit, offset int) ([]domain.User, int, error) {
+	prefix := escapeLikePattern(query) + "%"
+
+	// Private profiles are only found by their exact username
+	where := `
+		disabled_at IS NULL AND deletion_scheduled_at IS NULL
+		AND (
+			username = $1
+			OR (
+				NOT is_private
+				AND (
+					username LIKE $2 OR LOWER(display_name) LIKE $2
+					OR username % $1 OR LOWER(display_name) % $1
+				)
+			)
+		)
+	`
+
+	var total int
+	if err := r.db.GetContext(ctx, &total, "SELECT COUNT(*) FROM users WHERE "+where, query, prefix); err != nil {
+		return nil, 0, fmt.Errorf("failed to count users: %w", err)
+	}
+
+	searchQuery := `
+		SELECT id, username, email, display_name, bio, profile_picture_url,
+			   password_hash, is_private, email_verified, theme, role, disabled_at, username_changed_at, deletion_scheduled_at, created_at, updated_at
+		FROM users
+		WHERE ` + where + `
+		ORDER BY
+			username = $1 DESC,
+			username LIKE $2 DESC,
+			GREATEST(similarity(username, $1), similarity(LOWER(display_name), $1)) DESC,
+			username
+		LIMIT $3 OFFSET $4
+	`
+
+	var users []domain.User
+	if err := r.db.SelectContext(ctx, &users, searchQuery, query, prefix, limit, offset); err != nil {
+		return nil, 0, fmt.Errorf("failed to search users: %w", err)
+	}
+
+	return users, total, nil
+}
+
+func (r *userRepository) IsUsernameReserved(ctx context.Context, username string, userID uuid.UUID) (bool, error) {
+	var reserved bool
+	query := `
+		SELECT EXISTS(
+			SELECT 1 FROM username_reservations
+			WHERE username = $1 AND user_id <> $2 AND reserved_until > NOW()
+		)
+	`
+
+	if err := r.db.GetContext(ctx, &reserved, query, username, userID); err != nil {
+		return false, fmt.Errorf("failed to check username reservation: %w", err)
+	}
+
+	return reserved, nil
+}
+
+func (r *userRepository) ChangeUsername(ctx context.Context, id uuid.UUID, oldUsername, newUsername string, reservedUntil time.Time) error {
+	ctx, cancel := r.db.withTimeout(ctx)
+	defer cancel()
+
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	// Taking back a name reserved for this user releases the reservation
+	if _, err := tx.ExecContext(ctx, `DELETE FROM username_reservations WHERE username = $1`, newUsername); err != nil {
+		return fmt.Errorf("failed to release username reservation: %w", err)
+	}
+
+	result, err := tx.ExecContext(ctx,
+		`UPDATE users SET username = $1, username_changed_at = NOW(), updated_at = NOW() WHERE id = $2`,
+		newUsername, id,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to change username: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("user not found")
+	}
+
+	reserveQuery := `
+		INSERT INTO username_reservations (username, user_id, reserved_until, created_at)
+		VALUES ($1, $2, $3, NOW())
+		ON CONFLICT (username) DO UPDATE SET
+			user_id = EXCLUDED.user_id,
+			reserved_until = EXCLUDED.reserved_until,
+			created_at = EXCLUDED.created_at
+	`
+	if _, err := tx.ExecContext(ctx, reserveQuery, oldUsername, id, reservedUntil); err != nil {
+		return fmt.Errorf("failed to reserve previous username: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// GetUserProfileStats computes every profile counter in a single round trip
+func (r *userRepository) GetUserProfileStats(ctx context.Context, id uuid.UUID) (*domain.UserProfileStats, error) {
+	var stats domain.UserProfileStats
+	query := `
+		SELECT
+			(SELECT COUNT(*) FROM reviews WHERE user_id = $1) AS review_count,
+			(SELECT COUNT(*) FROM movie_lists WHERE user_id = $1) AS movie_list_count,
+			(SELECT COUNT(*) FROM follows WHERE following_id = $1) AS follower_count,
+			(SELECT COUNT(*) FROM follows WHERE follower_id = $1) AS following_count
+	`
+
+	if err := r.db.GetContext(ctx, &stats, query, id); err != nil {
+		return nil, fmt.Errorf("failed to get user profile stats: %w", err)
+	}
+
+	return &stats, nil
+}
+
+func (r *userRepository) IsFollowing(ctx context.Context, followerID, followingID uuid.UUID) (bool, error) {
+	var following bool
+	query := `SELECT EXISTS(SELECT 1 FROM follows WHERE follower_id = $1 AND following_id = $2)`
+
+	if err := r.db.GetContext(ctx, &following, query, followerID, followingID); err != nil {
+		return false, fmt.Errorf("failed to check follow: %w", err)
+	}
+
+	return following, nil
+}
+
+// escapeLikePattern escapes the LIKE wildcards of user input
+func escapeLikePattern(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(s)
+}
@@ -0,0 +1,96 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/EduardoMG12/cine/api_v2/internal/domain"
+	"github.com/google/uuid"
+)
+
+type watchedEpisodeRepository struct {
+	db *DB
+}
+
+func NewWatchedEpisodeRepository(db *DB) domain.WatchedEpisodeRepository {
+	return &watchedEpisodeRepository{db: db}
+}
+
+func (r *watchedEpisodeRepository) AddWatchedEpisode(ctx context.Context, userID, episodeID uuid.UUID) (*domain.WatchedEpisode, error) {
+	var watched domain.WatchedEpisode
+
+	query := `
+		INSERT INTO watched_episodes (user_id, episode_id)
+		VALUES ($1, $2)
+		ON CONFLICT (user_id, episode_id)
+		DO UPDATE SET watched_at = CURRENT_TIMESTAMP
+		RETURNING id, user_id, episode_id, watched_at, created_at
+	`
+
+	err := r.db.GetContext(ctx, &watched, query, userID, episodeID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to add watched episode: %w", err)
+	}
+
+	return &watched, nil
+}
+
+func (r *watchedEpisodeRepository) RemoveWatchedEpisode(ctx context.Context, userID, episodeID uuid.UUID) error {
+	query := `
+		DELETE FROM watched_episodes
+		WHERE user_id = $1 AND episode_id = $2
+	`
+
+	result, err := r.db.ExecContext(ctx, query, userID, episodeID)
+	if err != nil {
+		return fmt.Errorf("failed to remove watched episode: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+func (r *watchedEpisodeRepository) IsEpisodeWatched(ctx context.Context, userID, episodeID uuid.UUID) (bool, error) {
+	var exists bool
+	query := `
+		SELECT EXISTS(
+			SELECT 1 FROM watched_episodes
+			WHERE user_id = $1 AND episode_id = $2
+		)
+	`
+
+	err := r.db.GetContext(ctx, &exists, query, userID, episodeID)
+	if err != nil {
+		return false, fmt.Errorf("failed to check if episode is watched: %w", err)
+	}
+
+	return exists, nil
+}
+
+func (r *watchedEpisodeRepository) GetWatchedEpisodesOfSeries(ctx context.Context, userID, seriesID uuid.UUID) ([]domain.WatchedEpisode, error) {
+	watched := []domain.WatchedEpisode{}
+
+	query := `
+		SELECT we.id, we.user_id, we.episode_id, we.watched_at, we.created_at
+		FROM watched_episodes we
+		JOIN episodes e ON e.id = we.episode_id
+		WHERE we.user_id = $1 AND e.series_id = $2
+		ORDER BY e.season_number, e.episode_number
+	`
+
+	err := r.db.SelectContext(ctx, &watched, query, userID, seriesID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get watched episodes: %w", err)
+	}
+
+	return watched, nil
+}
@@ -1,23 +1,23 @@
 package repository
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 
 	"github.com/EduardoMG12/cine/api_v2/internal/domain"
 	"github.com/google/uuid"
-	"github.com/jmoiron/sqlx"
 )
 
 type watchedMovieRepository struct {
-	db *sqlx.DB
+	db *DB
 }
 
-func NewWatchedMovieRepository(db *sqlx.DB) domain.WatchedMovieRepository {
+func NewWatchedMovieRepository(db *DB) domain.WatchedMovieRepository {
 	return &watchedMovieRepository{db: db}
 }
 
-func (r *watchedMovieRepository) AddWatchedMovie(userID, movieID uuid.UUID) (*domain.WatchedMovie, error) {
+func (r *watchedMovieRepository) AddWatchedMovie(ctx context.Context, userID, movieID uuid.UUID) (*domain.WatchedMovie, error) {
 	var watched domain.WatchedMovie
 
 	query := `
@@ -28,7 +28,7 @@ func (r *watchedMovieRepository) AddWatchedMovie(userID, movieID uuid.UUID) (*do
 		RETURNING id, user_id, movie_id, watched_at, created_at
 	`
 
-	err := r.db.QueryRowx(query, userID, movieID).StructScan(&watched)
+	err := r.db.GetContext(ctx, &watched, query, userID, movieID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to add watched movie: %w", err)
 	}
@@ -36,13 +36,13 @@ func (r *watchedMovieRepository) AddWatchedMovie(userID, movieID uuid.UUID) (*do
 	return &watched, nil
 }
 
-func (r *watchedMovieRepository) RemoveWatchedMovie(userID, movieID uuid.UUID) error {
+func (r *watchedMovieRepository) RemoveWatchedMovie(ctx context.Context, userID, movieID uuid.UUID) error {
 	query := `
 		DELETE FROM watched_movies
 		WHERE user_id = $1 AND movie_id = $2
 	`
 
-	result, err := r.db.Exec(query, userID, movieID)
+	result, err := r.db.ExecContext(ctx, query, userID, movieID)
 	if err != nil {
 		return fmt.Errorf("failed to remove watched movie: %w", err)
 	}
@@ -59,7 +59,7 @@ func (r *watchedMovieRepository) RemoveWatchedMovie(userID, movieID uuid.UUID) e
 	return nil
 }
 
-func (r *watchedMovieRepository) IsMovieWatched(userID, movieID uuid.UUID) (bool, error) {
+func (r *watchedMovieRepository) IsMovieWatched(ctx context.Context, userID, movieID uuid.UUID) (bool, error) {
 	var exists bool
 	query := `
 		SELECT EXISTS(
@@ -68,7 +68,7 @@ func (r *watchedMovieRepository) IsMovieWatched(userID, movieID uuid.UUID) (bool
 		)
 	`
 
-	err := r.db.QueryRow(query, userID, movieID).Scan(&exists)
+	err := r.db.GetContext(ctx, &exists, query, userID, movieID)
 	if err != nil {
 		return false, fmt.Errorf("failed to check if movie is watched: %w", err)
 	}
@@ -76,7 +76,7 @@ func (r *watchedMovieRepository) IsMovieWatched(userID, movieID uuid.UUID) (bool
 	return exists, nil
 }
 
-func (r *watchedMovieRepository) GetUserWatchedMovies(userID uuid.UUID) ([]domain.WatchedMovie, error) {
+func (r *watchedMovieRepository) GetUserWatchedMovies(ctx context.Context, userID uuid.UUID) ([]domain.WatchedMovie, error) {
 	var watched []domain.WatchedMovie
 
 	query := `
@@ -86,7 +86,7 @@ func (r *watchedMovieRepository) GetUserWatchedMovies(userID uuid.UUID) ([]domai
 		ORDER BY watched_at DESC
 	`
 
-	err := r.db.Select(&watched, query, userID)
+	err := r.db.SelectContext(ctx, &watched, query, userID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get user watched movies: %w", err)
 	}
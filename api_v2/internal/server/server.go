@@ -16,24 +16,38 @@ import (
 
 	_ "github.com/EduardoMG12/cine/api_v2/docs"
 	"github.com/EduardoMG12/cine/api_v2/internal/config"
+	"github.com/EduardoMG12/cine/api_v2/internal/domain"
 	httpHandler "github.com/EduardoMG12/cine/api_v2/internal/handler/http"
 	"github.com/EduardoMG12/cine/api_v2/internal/infrastructure"
 	customMiddleware "github.com/EduardoMG12/cine/api_v2/internal/middleware"
 	"github.com/EduardoMG12/cine/api_v2/internal/repository"
+	"github.com/EduardoMG12/cine/api_v2/internal/usecase/admin"
 	"github.com/EduardoMG12/cine/api_v2/internal/usecase/auth"
 	"github.com/EduardoMG12/cine/api_v2/internal/usecase/movie"
+	"github.com/EduardoMG12/cine/api_v2/internal/usecase/person"
+	"github.com/EduardoMG12/cine/api_v2/internal/usecase/series"
 	"github.com/EduardoMG12/cine/api_v2/internal/usecase/user"
 	"github.com/EduardoMG12/cine/api_v2/internal/usecase/user_movie"
+	"github.com/EduardoMG12/cine/api_v2/internal/worker"
 )
 
 type Server struct {
 	config     *config.Config
 	db         *sqlx.DB
+	redis      *infrastructure.RedisService
+	jwtKeys    *infrastructure.JWTKeyManager
+	workers    []backgroundWorker
 	httpServer *http.Server
 	logger     *slog.Logger
 	router     *chi.Mux
 }
 
+// backgroundWorker is a periodic job running for the lifetime of the server
+type backgroundWorker interface {
+	Start()
+	Stop()
+}
+
 type RouteInfo struct {
 	Method  string
 	Path    string
@@ -64,26 +78,29 @@ func NewServer(cfg *config.Config, logger *slog.Logger) (*Server, error) {
 	}
 
 	// Setup HTTP server
-	server.setupHTTPServer()
+	if err := server.setupHTTPServer(); err != nil {
+		return nil, err
+	}
 
 	return server, nil
 }
 
-func (s *Server) setupHTTPServer() {
+func (s *Server) setupHTTPServer() error {
 	r := chi.NewRouter()
 	s.router = r
 
 	// Global middleware
 	r.Use(middleware.RequestID)
+	r.Use(customMiddleware.RealIP(s.config.Server.TrustedProxies))
 	r.Use(middleware.Logger)
 	r.Use(middleware.Recoverer)
-	r.Use(middleware.Timeout(60 * time.Second))
+	r.Use(middleware.Timeout(s.config.Server.RequestTimeout))
 
 	// CORS middleware
 	r.Use(func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			w.Header().Set("Access-Control-Allow-Origin", "*")
-			w.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, DELETE, OPTIONS")
+			w.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, PATCH, DELETE, OPTIONS")
 			w.Header().Set("Access-Control-Allow-Headers", "Accept, Authorization, Content-Type, X-CSRF-Token")
 
 			if r.Method == "OPTIONS" {
@@ -96,68 +113,337 @@ func (s *Server) setupHTTPServer() {
 
 	// Initialize infrastructure
 	passwordService := infrastructure.NewPasswordService()
-	jwtService := infrastructure.NewJWTService(s.config.JWT.Secret)
-	_, err := infrastructure.NewRedisService(s.config.Redis.Host, s.config.Redis.Port, s.config.Redis.Password, s.config.Redis.DB)
+	totpService := infrastructure.NewTOTPService(s.config.Auth.MFAIssuer)
+	jwtKeys, err := infrastructure.NewJWTKeyManager(
+		s.config.JWT.KeysDir,
+		s.config.JWT.Algorithm,
+		s.config.JWT.KeyRotationInterval,
+		s.config.JWT.KeyGracePeriod,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to initialize JWT keys: %w", err)
+	}
+	jwtKeys.Start()
+	s.jwtKeys = jwtKeys
+	jwtService := infrastructure.NewJWTService(jwtKeys, s.config.JWT.ExpirationTime, s.config.JWT.Issuer)
+	redisService, err := infrastructure.NewRedisService(s.config.Redis.Host, s.config.Redis.Port, s.config.Redis.Password, s.config.Redis.DB)
 	if err != nil {
 		s.logger.Error("Failed to initialize Redis service", "error", err)
 		// Continue without Redis, caching will be disabled
 	}
-	omdbService := infrastructure.NewOMDbService(s.config.OMDb.APIKey)
+	s.redis = redisService
+	sessionCache := infrastructure.NewSessionCache(redisService, s.config.Auth.SessionCacheTTL)
+	loginThrottler := infrastructure.NewLoginThrottler(
+		redisService,
+		s.config.Auth.LoginMaxFailuresPerEmail,
+		s.config.Auth.LoginMaxFailuresPerIP,
+		s.config.Auth.LoginFailureWindow,
+		s.config.Auth.LoginLockoutBase,
+		s.config.Auth.LoginLockoutMax,
+	)
+	// OMDb calls rotate across the configured keys, each with its own daily budget
+	omdbQuota := infrastructure.NewOMDbQuotaTracker(s.config.OMDb.Keys(), s.config.OMDb.DailyLimit, redisService)
+	omdbService := infrastructure.NewOMDbService(omdbQuota, s.config.OMDb.BaseURL, s.config.OMDb.Timeout)
+	mailer, err := infrastructure.NewMailer(
+		s.config.Mail.Driver,
+		s.config.Mail.From,
+		s.config.Mail.OutboxDir,
+		s.config.Mail.SMTPHost,
+		s.config.Mail.SMTPPort,
+		s.config.Mail.SMTPUser,
+		s.config.Mail.SMTPPassword,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to initialize mailer: %w", err)
+	}
 
-	// Initialize repositories
-	userRepo := repository.NewUserRepository(s.db)
-	sessionRepo := repository.NewSessionRepository(s.db)
-	movieRepo := repository.NewMovieRepository(s.db)
-	watchedMovieRepo := repository.NewWatchedMovieRepository(s.db)
-	favoriteMovieRepo := repository.NewFavoriteMovieRepository(s.db)
+	// Initialize repositories; every query is bounded by DB_QUERY_TIMEOUT
+	repoDB := repository.NewDB(s.db, s.config.Database.QueryTimeout)
+	userRepo := repository.NewUserRepository(repoDB)
+	sessionRepo := repository.NewSessionRepository(repoDB)
+	refreshTokenRepo := repository.NewRefreshTokenRepository(repoDB)
+	passwordResetTokenRepo := repository.NewPasswordResetTokenRepository(repoDB)
+	emailVerificationTokenRepo := repository.NewEmailVerificationTokenRepository(repoDB)
+	totpRepo := repository.NewTOTPRepository(repoDB)
+	recoveryCodeRepo := repository.NewRecoveryCodeRepository(repoDB)
+	mfaChallengeRepo := repository.NewMFAChallengeRepository(repoDB)
+	loginLockoutRepo := repository.NewLoginLockoutRepository(repoDB)
+	emailChangeTokenRepo := repository.NewEmailChangeTokenRepository(repoDB)
+	userDataExportRepo := repository.NewUserDataExportRepository(repoDB)
+	personalAccessTokenRepo := repository.NewPersonalAccessTokenRepository(repoDB)
+	// Movie writes invalidate the Redis movie cache
+	movieCache := infrastructure.NewMovieCache(
+		redisService,
+		s.config.Movies.CacheTTL,
+		s.config.Movies.SearchCacheTTL,
+		s.config.Movies.NotFoundCacheTTL,
+	)
+	movieRepo := infrastructure.NewCacheInvalidatingMovieRepository(repository.NewMovieRepository(repoDB), movieCache)
+	watchedMovieRepo := repository.NewWatchedMovieRepository(repoDB)
+	favoriteMovieRepo := repository.NewFavoriteMovieRepository(repoDB)
+	personRepo := repository.NewPersonRepository(repoDB)
+	seriesRepo := repository.NewSeriesRepository(repoDB)
+	watchedEpisodeRepo := repository.NewWatchedEpisodeRepository(repoDB)
+
+	// TMDb joins the chain only when an API key is configured
+	var tmdbService *infrastructure.TMDbService
+	if s.config.TMDb.APIKey != "" {
+		tmdbService = infrastructure.NewTMDbService(
+			s.config.TMDb.APIKey,
+			s.config.TMDb.BaseURL,
+			s.config.TMDb.ImageBaseURL,
+			s.config.TMDb.Language,
+			s.config.TMDb.Timeout,
+		)
+	}
 
-	// Initialize movie fetcher chain: OMDb -> Database (with auto-save enabled)
-	movieFetcher := infrastructure.NewMovieFetcherChain(omdbService, movieRepo, true)
+	// Initialize movie fetcher chain in the configured order
+	// (default Redis -> stored movies -> OMDb -> TMDb -> Database)
+	movieChain, err := infrastructure.NewMovieFetcherChain(
+		infrastructure.MovieFetcherChainOptions{
+			Providers:               s.config.Movies.Providers,
+			AutoSave:                s.config.Movies.AutoSave,
+			BreakerFailureThreshold: s.config.Movies.BreakerFailureThreshold,
+			BreakerOpenDuration:     s.config.Movies.BreakerOpenDuration,
+			Cache:                   movieCache,
+			DatabaseFirst:           s.config.Movies.DatabaseFirst,
+			RefreshConcurrency:      s.config.Movies.RefreshConcurrency,
+			FetchTimeout:            s.config.Movies.FetchTimeout,
+			OMDbQuota:               omdbQuota,
+		},
+		omdbService,
+		tmdbService,
+		movieRepo,
+	)
+	if err != nil {
+		return err
+	}
+	movieFetcher := movieChain.Fetcher
 
 	// Initialize auth use cases
-	registerUC := auth.NewRegisterUseCase(userRepo, sessionRepo, passwordService, jwtService)
-	loginUC := auth.NewLoginUseCase(userRepo, sessionRepo, passwordService, jwtService)
+	sessionIssuer := auth.NewSessionIssuer(sessionRepo, refreshTokenRepo, jwtService, s.config.JWT.RefreshTime)
+	verificationIssuer := auth.NewVerificationIssuer(emailVerificationTokenRepo, mailer, s.config.Mail.AppURL, s.config.Auth.EmailVerificationTTL)
+	registerUC := auth.NewRegisterUseCase(userRepo, passwordService, sessionIssuer, verificationIssuer)
+	loginGuard := auth.NewLoginGuard(loginThrottler, loginLockoutRepo)
+	mfaVerifier := auth.NewMFAVerifier(userRepo, totpRepo, recoveryCodeRepo, passwordService, totpService, loginGuard)
+	loginUC := auth.NewLoginUseCase(
+		userRepo,
+		passwordService,
+		sessionIssuer,
+		mfaVerifier,
+		mfaChallengeRepo,
+		s.config.Auth.MFAChallengeTTL,
+		loginGuard,
+	)
 	getMeUC := auth.NewGetMeUseCase(userRepo)
-	logoutUC := auth.NewLogoutUseCase(sessionRepo)
-	logoutAllUC := auth.NewLogoutAllUseCase(sessionRepo)
+	logoutUC := auth.NewLogoutUseCase(sessionRepo, sessionCache)
+	logoutAllUC := auth.NewLogoutAllUseCase(sessionRepo, sessionCache)
+	refreshUC := auth.NewRefreshTokenUseCase(userRepo, sessionRepo, refreshTokenRepo, sessionIssuer, sessionCache)
+	forgotPasswordUC := auth.NewForgotPasswordUseCase(
+		userRepo,
+		passwordResetTokenRepo,
+		mailer,
+		s.config.Mail.AppURL,
+		s.config.Auth.PasswordResetTTL,
+		s.config.Auth.PasswordResetCooldown,
+		s.config.Auth.PasswordResetHourlyLimit,
+	)
+	resetPasswordUC := auth.NewResetPasswordUseCase(userRepo, passwordResetTokenRepo, sessionRepo, sessionCache, passwordService)
+	listSessionsUC := auth.NewListSessionsUseCase(sessionRepo)
+	revokeSessionUC := auth.NewRevokeSessionUseCase(sessionRepo, sessionCache)
+	verifyEmailUC := auth.NewVerifyEmailUseCase(userRepo, emailVerificationTokenRepo)
+	resendVerificationUC := auth.NewResendVerificationUseCase(
+		userRepo,
+		emailVerificationTokenRepo,
+		verificationIssuer,
+		s.config.Auth.EmailVerificationCooldown,
+		s.config.Auth.EmailVerificationHourlyLimit,
+	)
+	setupTOTPUC := auth.NewSetupTOTPUseCase(userRepo, totpRepo, totpService, mfaVerifier)
+	confirmTOTPUC := auth.NewConfirmTOTPUseCase(totpRepo, totpService, mfaVerifier)
+	disableTOTPUC := auth.NewDisableTOTPUseCase(totpRepo, recoveryCodeRepo, mfaVerifier)
+	regenerateRecoveryCodesUC := auth.NewRegenerateRecoveryCodesUseCase(mfaVerifier)
+	verifyMFALoginUC := auth.NewVerifyMFALoginUseCase(userRepo, mfaChallengeRepo, mfaVerifier, sessionIssuer, loginGuard)
+	listPersonalAccessTokensUC := auth.NewListPersonalAccessTokensUseCase(personalAccessTokenRepo)
+	createPersonalAccessTokenUC := auth.NewCreatePersonalAccessTokenUseCase(personalAccessTokenRepo)
+	revokePersonalAccessTokenUC := auth.NewRevokePersonalAccessTokenUseCase(personalAccessTokenRepo)
 
 	// Initialize movie use cases
-	getMovieByIDUC := movie.NewGetMovieByIDUseCase(movieFetcher)
+	getMovieByIDUC := movie.NewGetMovieByIDUseCase(movieFetcher, movieRepo)
 	getRandomMovieUC := movie.NewGetRandomMovieUseCase(movieRepo)
 	getRandomMovieByGenreUC := movie.NewGetRandomMovieByGenreUseCase(movieRepo)
 	searchMoviesUC := movie.NewSearchMoviesUseCase(movieFetcher)
-	getTrendingMoviesUC := movie.NewGetTrendingMoviesUseCase(movieRepo, movieFetcher)
+	// Seeds an empty catalog in the background, one instance at a time
+	catalogSeedJob := worker.NewCatalogSeedJob(
+		movieFetcher,
+		redisService,
+		omdbQuota,
+		s.config.Movies.SeedConcurrency,
+		s.config.Movies.SeedCooldown,
+	)
+	catalogSeedJob.Start()
+	s.workers = append(s.workers, catalogSeedJob)
+	getTrendingMoviesUC := movie.NewGetTrendingMoviesUseCase(movieRepo, catalogSeedJob)
+	browseMoviesUC := movie.NewBrowseMoviesUseCase(movieRepo)
+
+	// Initialize person use cases
+	getPersonUC := person.NewGetPersonUseCase(personRepo)
+	searchPeopleUC := person.NewSearchPeopleUseCase(personRepo)
+
+	// Initialize series use cases
+	getSeasonsUC := series.NewGetSeasonsUseCase(movieFetcher, seriesRepo)
+	seasonFetcher := infrastructure.NewSeasonFetcher(
+		omdbService,
+		movieChain.Breaker(omdbService.GetProviderName()),
+		omdbQuota,
+		redisService,
+		s.config.Movies.NotFoundCacheTTL,
+	)
+	getSeasonEpisodesUC := series.NewGetSeasonEpisodesUseCase(movieFetcher, seriesRepo, seasonFetcher)
 
 	// Initialize user movie use cases
 	toggleWatchedMovieUC := user_movie.NewToggleWatchedMovieUseCase(watchedMovieRepo, movieRepo)
 	getWatchedMoviesUC := user_movie.NewGetWatchedMoviesUseCase(watchedMovieRepo, movieRepo)
 	toggleFavoriteMovieUC := user_movie.NewToggleFavoriteMovieUseCase(favoriteMovieRepo, movieRepo)
 	getFavoriteMoviesUC := user_movie.NewGetFavoriteMoviesUseCase(favoriteMovieRepo, movieRepo)
+	toggleWatchedEpisodeUC := user_movie.NewToggleWatchedEpisodeUseCase(watchedEpisodeRepo, seriesRepo)
+	getSeriesProgressUC := user_movie.NewGetSeriesProgressUseCase(watchedEpisodeRepo, seriesRepo, movieRepo)
 
 	// Initialize user use cases
-	updateUserUC := user.NewUpdateUserUseCase(userRepo)
+	updateUserUC := user.NewUpdateUserUseCase(
+		userRepo,
+		s.config.Auth.UsernameChangeCooldown,
+		s.config.Auth.UsernameReservationPeriod,
+	)
+	changePasswordUC := user.NewChangePasswordUseCase(userRepo, sessionRepo, sessionCache, passwordService)
+	requestEmailChangeUC := user.NewRequestEmailChangeUseCase(
+		userRepo,
+		emailChangeTokenRepo,
+		passwordService,
+		mailer,
+		s.config.Mail.AppURL,
+		s.config.Auth.EmailChangeTTL,
+	)
+	confirmEmailChangeUC := user.NewConfirmEmailChangeUseCase(
+		userRepo,
+		emailChangeTokenRepo,
+		emailVerificationTokenRepo,
+		sessionRepo,
+		sessionCache,
+	)
+	deleteAccountUC := user.NewDeleteAccountUseCase(
+		userRepo,
+		sessionRepo,
+		sessionCache,
+		personalAccessTokenRepo,
+		passwordService,
+		s.config.Auth.AccountDeletionGracePeriod,
+	)
+	exportUserDataUC := user.NewExportUserDataUseCase(userDataExportRepo)
+	checkUsernameUC := user.NewCheckUsernameUseCase(userRepo)
+	searchUsersUC := user.NewSearchUsersUseCase(userRepo)
+	getPublicProfileUC := user.NewGetPublicProfileUseCase(userRepo)
+	getProfileWatchedUC := user.NewGetProfileWatchedMoviesUseCase(userRepo, watchedMovieRepo, movieRepo)
+	getProfileFavoriteUC := user.NewGetProfileFavoriteMoviesUseCase(userRepo, favoriteMovieRepo, movieRepo)
+
+	// Initialize admin use cases
+	listUsersUC := admin.NewListUsersUseCase(userRepo)
+	disableUserUC := admin.NewDisableUserUseCase(userRepo, sessionRepo, sessionCache, personalAccessTokenRepo)
+	enableUserUC := admin.NewEnableUserUseCase(userRepo)
+	forceLogoutUC := admin.NewForceLogoutUseCase(userRepo, sessionRepo, sessionCache)
+	updateUserRoleUC := admin.NewUpdateUserRoleUseCase(userRepo)
+	adminUpdateMovieUC := admin.NewUpdateMovieUseCase(movieRepo)
+	adminDeleteMovieUC := admin.NewDeleteMovieUseCase(movieRepo)
+	providerStatusUC := admin.NewGetProviderStatusUseCase(movieChain.Breakers)
+	movieCacheStatsUC := admin.NewGetMovieCacheStatsUseCase(movieCache)
+
+	catalogRefreshWorker := worker.NewCatalogRefreshWorker(
+		movieRepo,
+		movieChain.Refresher,
+		omdbQuota,
+		redisService,
+		s.config.Movies.CatalogRefreshInterval,
+		s.config.Movies.CatalogRefreshBudget,
+		s.config.Movies.CatalogRefreshRetry,
+	)
+	catalogRefreshStatusUC := admin.NewGetCatalogRefreshStatusUseCase(catalogRefreshWorker)
+	omdbQuotaUC := admin.NewGetOMDbQuotaUseCase(omdbQuota)
+	catalogSeedStatusUC := admin.NewGetCatalogSeedStatusUseCase(catalogSeedJob)
+
+	// Initialize background workers
+	accountPurgeWorker := worker.NewAccountPurgeWorker(userRepo, s.config.Auth.AccountPurgeInterval)
+	accountPurgeWorker.Start()
+	s.workers = append(s.workers, accountPurgeWorker)
+	catalogRefreshWorker.Start()
+	s.workers = append(s.workers, catalogRefreshWorker)
 
 	// Initialize handlers
 	systemHandler := httpHandler.NewSystemHandler()
-	authHandler := httpHandler.NewAuthHandler(registerUC, loginUC, getMeUC, logoutUC, logoutAllUC)
+	authHandler := httpHandler.NewAuthHandler(registerUC, loginUC, getMeUC, logoutUC, logoutAllUC, refreshUC)
+	passwordHandler := httpHandler.NewPasswordHandler(forgotPasswordUC, resetPasswordUC)
+	emailVerificationHandler := httpHandler.NewEmailVerificationHandler(verifyEmailUC, resendVerificationUC)
+	sessionHandler := httpHandler.NewSessionHandler(listSessionsUC, revokeSessionUC)
+	mfaHandler := httpHandler.NewMFAHandler(setupTOTPUC, confirmTOTPUC, disableTOTPUC, regenerateRecoveryCodesUC, verifyMFALoginUC)
 	movieHandler := httpHandler.NewMovieHandler(
 		getMovieByIDUC,
 		getRandomMovieUC,
 		getRandomMovieByGenreUC,
 		searchMoviesUC,
 		getTrendingMoviesUC,
+		browseMoviesUC,
 	)
+	personHandler := httpHandler.NewPersonHandler(getPersonUC, searchPeopleUC)
+	seriesHandler := httpHandler.NewSeriesHandler(getSeasonsUC, getSeasonEpisodesUC)
 	omdbHandler := httpHandler.NewOMDbHandler(omdbService)
 	watchedMovieHandler := httpHandler.NewWatchedMovieHandler(toggleWatchedMovieUC, getWatchedMoviesUC)
+	watchedEpisodeHandler := httpHandler.NewWatchedEpisodeHandler(toggleWatchedEpisodeUC, getSeriesProgressUC)
 	favoriteMovieHandler := httpHandler.NewFavoriteMovieHandler(toggleFavoriteMovieUC, getFavoriteMoviesUC)
-	userHandler := httpHandler.NewUserHandler(updateUserUC)
+	userHandler := httpHandler.NewUserHandler(
+		updateUserUC,
+		changePasswordUC,
+		requestEmailChangeUC,
+		confirmEmailChangeUC,
+		deleteAccountUC,
+		exportUserDataUC,
+		checkUsernameUC,
+		searchUsersUC,
+		getPublicProfileUC,
+		getProfileWatchedUC,
+		getProfileFavoriteUC,
+	)
+	personalAccessTokenHandler := httpHandler.NewPersonalAccessTokenHandler(
+		listPersonalAccessTokensUC,
+		createPersonalAccessTokenUC,
+		revokePersonalAccessTokenUC,
+	)
+	adminHandler := httpHandler.NewAdminHandler(
+		listUsersUC,
+		disableUserUC,
+		enableUserUC,
+		forceLogoutUC,
+		updateUserRoleUC,
+		adminUpdateMovieUC,
+		adminDeleteMovieUC,
+		providerStatusUC,
+		movieCacheStatsUC,
+		catalogRefreshStatusUC,
+		omdbQuotaUC,
+		catalogSeedStatusUC,
+	)
+	jwksHandler := httpHandler.NewJWKSHandler(jwtKeys)
 
 	// System routes
 	r.Get("/", systemHandler.Root)
 	r.Get("/health", systemHandler.HealthCheck)
+	r.Get("/.well-known/jwks.json", jwksHandler.GetJWKS)
 
 	// Initialize middleware
-	authMiddleware := customMiddleware.JWTAuthMiddleware(jwtService, userRepo)
+	authMiddleware := customMiddleware.JWTAuthMiddleware(jwtService, userRepo, sessionRepo, sessionCache)
+	// Routes that also accept personal access tokens must require a scope
+	tokenAuthMiddleware := customMiddleware.TokenAuthMiddleware(personalAccessTokenRepo, userRepo, authMiddleware)
+	requireScope := customMiddleware.RequireScope
+	requirePermission := customMiddleware.RequirePermission
+	verifiedEmailMiddleware := customMiddleware.RequireVerifiedEmail(s.config.Auth.RequireVerifiedEmailForWriting)
 
 	// Setup API routes
 	r.Route("/api/v1", func(r chi.Router) {
@@ -166,18 +452,37 @@ func (s *Server) setupHTTPServer() {
 			// Public routes
 			r.Post("/register", authHandler.Register)
 			r.Post("/login", authHandler.Login)
+			r.Post("/refresh", authHandler.Refresh)
+			r.Post("/password/forgot", passwordHandler.ForgotPassword)
+			r.Post("/password/reset", passwordHandler.ResetPassword)
+			r.Post("/email/verify", emailVerificationHandler.VerifyEmail)
+			r.Post("/email/change/confirm", userHandler.ConfirmEmailChange)
+			r.Post("/mfa/verify", mfaHandler.VerifyLogin)
+
+			// Protected routes, also reachable with a personal access token
+			r.With(tokenAuthMiddleware, requireScope(domain.ScopeProfileRead)).Get("/me", authHandler.GetMe)
 
 			// Protected routes
 			r.Group(func(r chi.Router) {
 				r.Use(authMiddleware)
-				r.Get("/me", authHandler.GetMe)
 				r.Post("/logout", authHandler.Logout)
 				r.Post("/logout-all", authHandler.LogoutAll)
+				r.Post("/email/resend", emailVerificationHandler.ResendVerification)
+				r.Get("/sessions", sessionHandler.ListSessions)
+				r.Delete("/sessions/{id}", sessionHandler.RevokeSession)
+				r.Post("/mfa/totp/setup", mfaHandler.SetupTOTP)
+				r.Post("/mfa/totp/confirm", mfaHandler.ConfirmTOTP)
+				r.Post("/mfa/disable", mfaHandler.DisableTOTP)
+				r.Post("/mfa/recovery-codes", mfaHandler.RegenerateRecoveryCodes)
+				r.Get("/tokens", personalAccessTokenHandler.ListTokens)
+				r.Post("/tokens", personalAccessTokenHandler.CreateToken)
+				r.Delete("/tokens/{id}", personalAccessTokenHandler.RevokeToken)
 			})
 		})
 
 		// Movie routes (all public)
 		r.Route("/movies", func(r chi.Router) {
+			r.Get("/", movieHandler.BrowseMovies)
 			r.Get("/trending", movieHandler.GetTrendingMovies)
 			r.Get("/random", movieHandler.GetRandomMovie)
 			r.Get("/random-by-genre", movieHandler.GetRandomMovieByGenre)
@@ -185,24 +490,76 @@ func (s *Server) setupHTTPServer() {
 			r.Get("/{id}", movieHandler.GetMovieByID)
 		})
 
+		// People routes (all public)
+		r.Route("/people", func(r chi.Router) {
+			r.Get("/search", personHandler.SearchPeople)
+			r.Get("/{id}", personHandler.GetPerson)
+		})
+
+		// Series routes (all public)
+		r.Route("/series", func(r chi.Router) {
+			r.Get("/{id}/seasons", seriesHandler.GetSeasons)
+			r.Get("/{id}/seasons/{season}", seriesHandler.GetSeasonEpisodes)
+		})
+
 		// Watched movies routes (protected)
 		r.Route("/watched", func(r chi.Router) {
-			r.Use(authMiddleware)
-			r.Get("/", watchedMovieHandler.GetWatchedMovies)
-			r.Post("/", watchedMovieHandler.ToggleWatchedMovie)
+			r.Use(tokenAuthMiddleware)
+			r.With(requireScope(domain.ScopeWatchedRead)).Get("/", watchedMovieHandler.GetWatchedMovies)
+			r.With(requireScope(domain.ScopeWatchedWrite), verifiedEmailMiddleware).Post("/", watchedMovieHandler.ToggleWatchedMovie)
+			r.With(requireScope(domain.ScopeWatchedWrite), verifiedEmailMiddleware).Post("/episodes", watchedEpisodeHandler.ToggleWatchedEpisode)
+			r.With(requireScope(domain.ScopeWatchedRead)).Get("/series/{id}", watchedEpisodeHandler.GetSeriesProgress)
 		})
 
 		// Favorite movies routes (protected)
 		r.Route("/favorites", func(r chi.Router) {
-			r.Use(authMiddleware)
-			r.Get("/", favoriteMovieHandler.GetFavoriteMovies)
-			r.Post("/", favoriteMovieHandler.ToggleFavoriteMovie)
+			r.Use(tokenAuthMiddleware)
+			r.With(requireScope(domain.ScopeFavoritesRead)).Get("/", favoriteMovieHandler.GetFavoriteMovies)
+			r.With(requireScope(domain.ScopeFavoritesWrite), verifiedEmailMiddleware).Post("/", favoriteMovieHandler.ToggleFavoriteMovie)
 		})
 
-		// User routes (protected)
+		// User routes
 		r.Route("/users", func(r chi.Router) {
+			// Public routes
+			r.Get("/check-username", userHandler.CheckUsername)
+			r.Get("/search", userHandler.SearchUsers)
+
+			// Protected routes
+			r.With(tokenAuthMiddleware, requireScope(domain.ScopeProfileWrite)).Patch("/me", userHandler.UpdateUser)
+
+			r.Group(func(r chi.Router) {
+				r.Use(authMiddleware)
+				r.Delete("/me", userHandler.DeleteAccount)
+				r.Get("/me/export", userHandler.ExportData)
+				r.Post("/me/password", userHandler.ChangePassword)
+				r.Post("/me/email", userHandler.RequestEmailChange)
+			})
+
+			// Public profiles, the viewer is identified when a token is sent
+			r.Group(func(r chi.Router) {
+				r.Use(customMiddleware.OptionalAuth(tokenAuthMiddleware))
+				r.Use(requireScope(domain.ScopeProfileRead))
+				r.Get("/{username}", userHandler.GetPublicProfile)
+				r.Get("/{username}/watched", userHandler.GetProfileWatchedMovies)
+				r.Get("/{username}/favorites", userHandler.GetProfileFavoriteMovies)
+			})
+		})
+
+		// Admin routes (protected, role based)
+		r.Route("/admin", func(r chi.Router) {
 			r.Use(authMiddleware)
-			r.Patch("/me", userHandler.UpdateUser)
+			r.With(requirePermission(domain.PermissionUsersRead)).Get("/users", adminHandler.ListUsers)
+			r.With(requirePermission(domain.PermissionUsersModerate)).Post("/users/{id}/disable", adminHandler.DisableUser)
+			r.With(requirePermission(domain.PermissionUsersModerate)).Post("/users/{id}/enable", adminHandler.EnableUser)
+			r.With(requirePermission(domain.PermissionUsersModerate)).Post("/users/{id}/logout", adminHandler.ForceLogout)
+			r.With(requirePermission(domain.PermissionUsersManageRoles)).Put("/users/{id}/role", adminHandler.UpdateUserRole)
+			r.With(requirePermission(domain.PermissionMoviesWrite)).Patch("/movies/{id}", adminHandler.UpdateMovie)
+			r.With(requirePermission(domain.PermissionMoviesDelete)).Delete("/movies/{id}", adminHandler.DeleteMovie)
+			r.With(requirePermission(domain.PermissionProvidersRead)).Get("/providers", adminHandler.GetProviderStatus)
+			r.With(requirePermission(domain.PermissionProvidersRead)).Get("/movie-cache", adminHandler.GetMovieCacheStats)
+			r.With(requirePermission(domain.PermissionProvidersRead)).Get("/catalog-refresh", adminHandler.GetCatalogRefreshStatus)
+			r.With(requirePermission(domain.PermissionProvidersRead)).Get("/omdb-quota", adminHandler.GetOMDbQuota)
+			r.With(requirePermission(domain.PermissionProvidersRead)).Get("/catalog-seed", adminHandler.GetCatalogSeedStatus)
 		})
 
 		// OMDb routes (test and search)
@@ -226,6 +583,8 @@ func (s *Server) setupHTTPServer() {
 		ReadTimeout:  s.config.Server.ReadTimeout,
 		WriteTimeout: s.config.Server.WriteTimeout,
 	}
+
+	return nil
 }
 
 func (s *Server) Start() error {
@@ -261,16 +620,27 @@ func (s *Server) printRoutes() {
 	movieRoutes := []RouteInfo{}
 	userMovieRoutes := []RouteInfo{}
 	userRoutes := []RouteInfo{}
+	adminRoutes := []RouteInfo{}
 
 	for _, route := range routes {
-		if strings.Contains(route.Path, "/movies") {
+		if strings.Contains(route.Path, "/admin") {
+			adminRoutes = append(adminRoutes, route)
+		} else if strings.Contains(route.Path, "/users/{username}") {
+			publicRoutes = append(publicRoutes, route)
+		} else if strings.Contains(route.Path, "/movies") || strings.HasPrefix(route.Path, "/api/v1/series") {
 			movieRoutes = append(movieRoutes, route)
 		} else if strings.Contains(route.Path, "/watched") || strings.Contains(route.Path, "/favorites") {
 			userMovieRoutes = append(userMovieRoutes, route)
+		} else if strings.HasSuffix(route.Path, "/users/check-username") || strings.HasSuffix(route.Path, "/users/search") {
+			publicRoutes = append(publicRoutes, route)
 		} else if strings.Contains(route.Path, "/users") {
 			userRoutes = append(userRoutes, route)
 		} else if strings.Contains(route.Path, "/auth/me") ||
-			strings.Contains(route.Path, "/auth/logout") {
+			strings.Contains(route.Path, "/auth/logout") ||
+			strings.Contains(route.Path, "/auth/email/resend") ||
+			strings.Contains(route.Path, "/auth/sessions") ||
+			strings.Contains(route.Path, "/auth/tokens") ||
+			(strings.Contains(route.Path, "/auth/mfa/") && !strings.HasSuffix(route.Path, "/mfa/verify")) {
 			protectedRoutes = append(protectedRoutes, route)
 		} else {
 			publicRoutes = append(publicRoutes, route)
@@ -317,6 +687,14 @@ func (s *Server) printRoutes() {
 		fmt.Println()
 	}
 
+	if len(adminRoutes) > 0 {
+		fmt.Println("  🛡️  Admin Routes (require moderator or admin role):")
+		for _, route := range adminRoutes {
+			fmt.Printf("    %-7s %s\n", colorizeMethod(route.Method), route.Path)
+		}
+		fmt.Println()
+	}
+
 	fmt.Println("─────────────────────────────────────────────────────────────────")
 	fmt.Println()
 }
@@ -359,6 +737,16 @@ func colorizeMethod(method string) string {
 func (s *Server) Stop(ctx context.Context) error {
 	s.logger.Info("Shutting down server...")
 
+	// Stop background workers
+	for _, w := range s.workers {
+		w.Stop()
+	}
+
+	// Stop JWT key rotation
+	if s.jwtKeys != nil {
+		s.jwtKeys.Stop()
+	}
+
 	// Close database connection
 	if s.db != nil {
 		if err := s.db.Close(); err != nil {
@@ -366,6 +754,13 @@ func (s *Server) Stop(ctx context.Context) error {
 		}
 	}
 
+	// Close Redis connection
+	if s.redis != nil {
+		if err := s.redis.Close(); err != nil {
+			s.logger.Error("Failed to close Redis connection", "error", err)
+		}
+	}
+
 	// Shutdown HTTP server
 	return s.httpServer.Shutdown(ctx)
 }
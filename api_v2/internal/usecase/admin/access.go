@@ -0,0 +1,44 @@
+package admin
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/EduardoMG12/cine/api_v2/internal/domain"
+	"github.com/EduardoMG12/cine/api_v2/internal/infrastructure"
+	"github.com/google/uuid"
+)
+
+// loadTarget returns the user an admin action applies to.
+// Staff accounts can only be managed by admins, and nobody can act on their own account.
+func loadTarget(ctx context.Context, userRepo domain.UserRepository, actor *domain.User, targetID uuid.UUID) (*domain.User, error) {
+	if actor.ID == targetID {
+		return nil, fmt.Errorf("cannot modify own account")
+	}
+
+	target, err := userRepo.GetUserByID(ctx, targetID)
+	if err != nil {
+		return nil, fmt.Errorf("user not found")
+	}
+
+	if target.Role != domain.RoleUser && actor.Role != domain.RoleAdmin {
+		return nil, fmt.Errorf("insufficient permissions")
+	}
+
+	return target, nil
+}
+
+// revokeSessions logs a user out of every device
+func revokeSessions(ctx context.Context, sessionRepo domain.SessionRepository, sessionCache *infrastructure.SessionCache, userID uuid.UUID) error {
+	sessions, err := sessionRepo.GetUserSessions(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("failed to revoke sessions: %w", err)
+	}
+
+	if err := sessionRepo.DeleteUserSessions(ctx, userID); err != nil {
+		return fmt.Errorf("failed to revoke sessions: %w", err)
+	}
+
+	sessionCache.RevokeSessions(ctx, sessions)
+	return nil
+}
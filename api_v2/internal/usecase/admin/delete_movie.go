@@ -0,0 +1,22 @@
+package admin
+
+import (
+	"context"
+
+	"github.com/EduardoMG12/cine/api_v2/internal/domain"
+	"github.com/google/uuid"
+)
+
+type DeleteMovieUseCase struct {
+	movieRepo domain.MovieRepository
+}
+
+func NewDeleteMovieUseCase(movieRepo domain.MovieRepository) *DeleteMovieUseCase {
+	return &DeleteMovieUseCase{
+		movieRepo: movieRepo,
+	}
+}
+
+func (uc *DeleteMovieUseCase) Execute(ctx context.Context, movieID uuid.UUID) error {
+	return uc.movieRepo.DeleteMovie(ctx, movieID)
+}
@@ -0,0 +1,61 @@
+package admin
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/EduardoMG12/cine/api_v2/internal/domain"
+	"github.com/EduardoMG12/cine/api_v2/internal/dto"
+	"github.com/EduardoMG12/cine/api_v2/internal/infrastructure"
+	"github.com/google/uuid"
+)
+
+type DisableUserUseCase struct {
+	userRepo     domain.UserRepository
+	sessionRepo  domain.SessionRepository
+	sessionCache *infrastructure.SessionCache
+	tokenRepo    domain.PersonalAccessTokenRepository
+}
+
+func NewDisableUserUseCase(
+	userRepo domain.UserRepository,
+	sessionRepo domain.SessionRepository,
+	sessionCache *infrastructure.SessionCache,
+	tokenRepo domain.PersonalAccessTokenRepository,
+) *DisableUserUseCase {
+	return &DisableUserUseCase{
+		userRepo:     userRepo,
+		sessionRepo:  sessionRepo,
+		sessionCache: sessionCache,
+		tokenRepo:    tokenRepo,
+	}
+}
+
+// Execute blocks the account and revokes every session and personal access token it has
+func (uc *DisableUserUseCase) Execute(ctx context.Context, actor *domain.User, targetID uuid.UUID) (*dto.AdminUserDTO, error) {
+	target, err := loadTarget(ctx, uc.userRepo, actor, targetID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !target.Disabled() {
+		now := time.Now()
+		if err := uc.userRepo.SetUserDisabled(ctx, target.ID, &now); err != nil {
+			return nil, err
+		}
+		target.DisabledAt = &now
+		log.Printf("[Admin] User %s disabled by %s", target.ID, actor.ID)
+	}
+
+	if err := revokeSessions(ctx, uc.sessionRepo, uc.sessionCache, target.ID); err != nil {
+		return nil, err
+	}
+
+	if err := uc.tokenRepo.DeleteUserPersonalAccessTokens(ctx, target.ID); err != nil {
+		return nil, err
+	}
+
+	result := userToAdminDTO(target)
+	return &result, nil
+}
@@ -0,0 +1,38 @@
+package admin
+
+import (
+	"context"
+	"log"
+
+	"github.com/EduardoMG12/cine/api_v2/internal/domain"
+	"github.com/EduardoMG12/cine/api_v2/internal/dto"
+	"github.com/google/uuid"
+)
+
+type EnableUserUseCase struct {
+	userRepo domain.UserRepository
+}
+
+func NewEnableUserUseCase(userRepo domain.UserRepository) *EnableUserUseCase {
+	return &EnableUserUseCase{
+		userRepo: userRepo,
+	}
+}
+
+func (uc *EnableUserUseCase) Execute(ctx context.Context, actor *domain.User, targetID uuid.UUID) (*dto.AdminUserDTO, error) {
+	target, err := loadTarget(ctx, uc.userRepo, actor, targetID)
+	if err != nil {
+		return nil, err
+	}
+
+	if target.Disabled() {
+		if err := uc.userRepo.SetUserDisabled(ctx, target.ID, nil); err != nil {
+			return nil, err
+		}
+		target.DisabledAt = nil
+		log.Printf("[Admin] User %s enabled by %s", target.ID, actor.ID)
+	}
+
+	result := userToAdminDTO(target)
+	return &result, nil
+}
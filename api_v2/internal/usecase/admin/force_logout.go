@@ -0,0 +1,43 @@
+package admin
+
+import (
+	"context"
+	"log"
+
+	"github.com/EduardoMG12/cine/api_v2/internal/domain"
+	"github.com/EduardoMG12/cine/api_v2/internal/infrastructure"
+	"github.com/google/uuid"
+)
+
+type ForceLogoutUseCase struct {
+	userRepo     domain.UserRepository
+	sessionRepo  domain.SessionRepository
+	sessionCache *infrastructure.SessionCache
+}
+
+func NewForceLogoutUseCase(
+	userRepo domain.UserRepository,
+	sessionRepo domain.SessionRepository,
+	sessionCache *infrastructure.SessionCache,
+) *ForceLogoutUseCase {
+	return &ForceLogoutUseCase{
+		userRepo:     userRepo,
+		sessionRepo:  sessionRepo,
+		sessionCache: sessionCache,
+	}
+}
+
+// Execute logs the user out of every device
+func (uc *ForceLogoutUseCase) Execute(ctx context.Context, actor *domain.User, targetID uuid.UUID) error {
+	target, err := loadTarget(ctx, uc.userRepo, actor, targetID)
+	if err != nil {
+		return err
+	}
+
+	if err := revokeSessions(ctx, uc.sessionRepo, uc.sessionCache, target.ID); err != nil {
+		return err
+	}
+
+	log.Printf("[Admin] User %s logged out by %s", target.ID, actor.ID)
+	return nil
+}
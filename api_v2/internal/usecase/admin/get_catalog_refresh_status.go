@@ -0,0 +1,40 @@
+package admin
+
+import (
+	"github.com/EduardoMG12/cine/api_v2/internal/dto"
+	"github.com/EduardoMG12/cine/api_v2/internal/worker"
+)
+
+type GetCatalogRefreshStatusUseCase struct {
+	refreshWorker *worker.CatalogRefreshWorker
+}
+
+func NewGetCatalogRefreshStatusUseCase(refreshWorker *worker.CatalogRefreshWorker) *GetCatalogRefreshStatusUseCase {
+	return &GetCatalogRefreshStatusUseCase{
+		refreshWorker: refreshWorker,
+	}
+}
+
+func (uc *GetCatalogRefreshStatusUseCase) Execute() *dto.CatalogRefreshStatusDTO {
+	stats := uc.refreshWorker.Stats()
+
+	return &dto.CatalogRefreshStatusDTO{
+		Enabled:           stats.Enabled,
+		Running:           stats.Running,
+		IntervalSeconds:   int64(stats.Interval.Seconds()),
+		HourlyBudget:      stats.HourlyBudget,
+		UsedThisHour:      stats.UsedThisHour,
+		BackedOffMovies:   stats.BackedOff,
+		CurrentRunTotal:   stats.CurrentRunTotal,
+		CurrentRunDone:    stats.CurrentRunDone,
+		LastRunStartedAt:  stats.LastRunStarted,
+		LastRunFinishedAt: stats.LastRunFinished,
+		LastRunDurationMS: stats.LastRunDuration.Milliseconds(),
+		LastRunSelected:   stats.LastRunSelected,
+		LastRunRefreshed:  stats.LastRunRefreshed,
+		LastRunFailed:     stats.LastRunFailed,
+		LastError:         stats.LastError,
+		TotalRefreshed:    stats.TotalRefreshed,
+		TotalFailed:       stats.TotalFailed,
+	}
+}
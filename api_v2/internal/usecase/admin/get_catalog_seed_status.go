@@ -0,0 +1,33 @@
+package admin
+
+import (
+	"context"
+
+	"github.com/EduardoMG12/cine/api_v2/internal/dto"
+	"github.com/EduardoMG12/cine/api_v2/internal/worker"
+)
+
+type GetCatalogSeedStatusUseCase struct {
+	seedJob *worker.CatalogSeedJob
+}
+
+func NewGetCatalogSeedStatusUseCase(seedJob *worker.CatalogSeedJob) *GetCatalogSeedStatusUseCase {
+	return &GetCatalogSeedStatusUseCase{
+		seedJob: seedJob,
+	}
+}
+
+func (uc *GetCatalogSeedStatusUseCase) Execute(ctx context.Context) *dto.CatalogSeedStatusDTO {
+	status := uc.seedJob.Status(ctx)
+
+	return &dto.CatalogSeedStatusDTO{
+		State:      status.State,
+		StartedAt:  status.StartedAt,
+		FinishedAt: status.FinishedAt,
+		Total:      status.Total,
+		Processed:  status.Processed,
+		Found:      status.Found,
+		Failed:     status.Failed,
+		LastError:  status.LastError,
+	}
+}
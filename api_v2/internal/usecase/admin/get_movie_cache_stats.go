@@ -0,0 +1,43 @@
+package admin
+
+import (
+	"github.com/EduardoMG12/cine/api_v2/internal/dto"
+	"github.com/EduardoMG12/cine/api_v2/internal/infrastructure"
+)
+
+type GetMovieCacheStatsUseCase struct {
+	movieCache *infrastructure.MovieCache
+}
+
+func NewGetMovieCacheStatsUseCase(movieCache *infrastructure.MovieCache) *GetMovieCacheStatsUseCase {
+	return &GetMovieCacheStatsUseCase{
+		movieCache: movieCache,
+	}
+}
+
+// Execute returns the movie cache counters; negative hits count as hits in the hit rate
+func (uc *GetMovieCacheStatsUseCase) Execute() *dto.MovieCacheStatsDTO {
+	stats := uc.movieCache.Stats()
+
+	return &dto.MovieCacheStatsDTO{
+		Enabled:            stats.Enabled,
+		Hits:               stats.Hits,
+		NegativeHits:       stats.NegativeHits,
+		Misses:             stats.Misses,
+		HitRate:            hitRate(stats.Hits+stats.NegativeHits, stats.Misses),
+		SearchHits:         stats.SearchHits,
+		SearchMisses:       stats.SearchMisses,
+		SearchHitRate:      hitRate(stats.SearchHits, stats.SearchMisses),
+		Invalidations:      stats.Invalidations,
+		MovieTTLSeconds:    int64(stats.MovieTTL.Seconds()),
+		SearchTTLSeconds:   int64(stats.SearchTTL.Seconds()),
+		NotFoundTTLSeconds: int64(stats.NotFoundTTL.Seconds()),
+	}
+}
+
+func hitRate(hits, misses int64) float64 {
+	if hits+misses == 0 {
+		return 0
+	}
+	return float64(hits) / float64(hits+misses)
+}
@@ -0,0 +1,42 @@
+package admin
+
+import (
+	"context"
+
+	"github.com/EduardoMG12/cine/api_v2/internal/dto"
+	"github.com/EduardoMG12/cine/api_v2/internal/infrastructure"
+)
+
+type GetOMDbQuotaUseCase struct {
+	quota *infrastructure.OMDbQuotaTracker
+}
+
+func NewGetOMDbQuotaUseCase(quota *infrastructure.OMDbQuotaTracker) *GetOMDbQuotaUseCase {
+	return &GetOMDbQuotaUseCase{
+		quota: quota,
+	}
+}
+
+// Execute reports today's usage of each OMDb key and whether the chain is in database-only mode
+func (uc *GetOMDbQuotaUseCase) Execute(ctx context.Context) *dto.OMDbQuotaDTO {
+	stats := uc.quota.Stats(ctx)
+
+	keys := make([]dto.OMDbKeyQuotaDTO, len(stats.Keys))
+	for i, key := range stats.Keys {
+		keys[i] = dto.OMDbKeyQuotaDTO{
+			Key:       key.Key,
+			Used:      key.Used,
+			Remaining: key.Remaining,
+			Exhausted: key.Exhausted,
+		}
+	}
+
+	return &dto.OMDbQuotaDTO{
+		DailyLimit:   stats.DailyLimit,
+		Day:          stats.Day,
+		ResetsAt:     stats.ResetsAt,
+		Remaining:    stats.Remaining,
+		DatabaseOnly: stats.Exhausted,
+		Keys:         keys,
+	}
+}
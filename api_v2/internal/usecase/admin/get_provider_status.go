@@ -0,0 +1,38 @@
+package admin
+
+import (
+	"github.com/EduardoMG12/cine/api_v2/internal/dto"
+	"github.com/EduardoMG12/cine/api_v2/internal/infrastructure"
+)
+
+type GetProviderStatusUseCase struct {
+	breakers []*infrastructure.CircuitBreaker
+}
+
+func NewGetProviderStatusUseCase(breakers []*infrastructure.CircuitBreaker) *GetProviderStatusUseCase {
+	return &GetProviderStatusUseCase{
+		breakers: breakers,
+	}
+}
+
+// Execute reports the circuit state, error rate and latency of each movie provider, in chain order
+func (uc *GetProviderStatusUseCase) Execute() []dto.ProviderStatusDTO {
+	result := make([]dto.ProviderStatusDTO, 0, len(uc.breakers))
+	for _, breaker := range uc.breakers {
+		stats := breaker.Stats()
+		result = append(result, dto.ProviderStatusDTO{
+			Provider:            stats.Provider,
+			State:               stats.State,
+			ConsecutiveFailures: stats.ConsecutiveFailures,
+			Calls:               stats.Calls,
+			Failures:            stats.Failures,
+			ErrorRate:           stats.ErrorRate,
+			AverageLatencyMS:    stats.AverageLatency.Milliseconds(),
+			OpenedAt:            stats.OpenedAt,
+			LastError:           stats.LastError,
+			LastErrorAt:         stats.LastErrorAt,
+		})
+	}
+
+	return result
+}
@@ -0,0 +1,81 @@
+package admin
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/EduardoMG12/cine/api_v2/internal/domain"
+	"github.com/EduardoMG12/cine/api_v2/internal/dto"
+)
+
+const (
+	defaultUsersPageSize = 20
+	maxUsersPageSize     = 100
+)
+
+type ListUsersUseCase struct {
+	userRepo domain.UserRepository
+}
+
+func NewListUsersUseCase(userRepo domain.UserRepository) *ListUsersUseCase {
+	return &ListUsersUseCase{
+		userRepo: userRepo,
+	}
+}
+
+// Execute lists users matching query (username, email or display name), role and status
+func (uc *ListUsersUseCase) Execute(ctx context.Context, query, role string, disabled *bool, page, pageSize int) (*dto.AdminUserListDTO, error) {
+	if role != "" && !domain.IsValidRole(role) {
+		return nil, fmt.Errorf("invalid role")
+	}
+
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 {
+		pageSize = defaultUsersPageSize
+	}
+	if pageSize > maxUsersPageSize {
+		pageSize = maxUsersPageSize
+	}
+
+	users, total, err := uc.userRepo.ListUsers(ctx, domain.UserListFilter{
+		Query:    strings.TrimSpace(query),
+		Role:     role,
+		Disabled: disabled,
+		Limit:    pageSize,
+		Offset:   (page - 1) * pageSize,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := &dto.AdminUserListDTO{
+		Users:    make([]dto.AdminUserDTO, 0, len(users)),
+		Total:    total,
+		Page:     page,
+		PageSize: pageSize,
+	}
+	for i := range users {
+		result.Users = append(result.Users, userToAdminDTO(&users[i]))
+	}
+
+	return result, nil
+}
+
+func userToAdminDTO(user *domain.User) dto.AdminUserDTO {
+	return dto.AdminUserDTO{
+		ID:                  user.ID,
+		Username:            user.Username,
+		Email:               user.Email,
+		DisplayName:         user.DisplayName,
+		Role:                user.Role,
+		EmailVerified:       user.EmailVerified,
+		IsPrivate:           user.IsPrivate,
+		DisabledAt:          user.DisabledAt,
+		DeletionScheduledAt: user.DeletionScheduledAt,
+		CreatedAt:           user.CreatedAt,
+		UpdatedAt:           user.UpdatedAt,
+	}
+}
@@ -0,0 +1,85 @@
+package admin
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/EduardoMG12/cine/api_v2/internal/domain"
+	"github.com/EduardoMG12/cine/api_v2/internal/dto"
+	"github.com/google/uuid"
+)
+
+type UpdateMovieUseCase struct {
+	movieRepo domain.MovieRepository
+}
+
+func NewUpdateMovieUseCase(movieRepo domain.MovieRepository) *UpdateMovieUseCase {
+	return &UpdateMovieUseCase{
+		movieRepo: movieRepo,
+	}
+}
+
+func (uc *UpdateMovieUseCase) Execute(ctx context.Context, movieID uuid.UUID, req *dto.AdminUpdateMovieRequest) (*dto.MovieDTO, error) {
+	movie, err := uc.movieRepo.GetMovieByID(ctx, movieID)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Title != nil {
+		title := strings.TrimSpace(*req.Title)
+		if title == "" {
+			return nil, fmt.Errorf("invalid title")
+		}
+		movie.Title = title
+	}
+	if req.Overview != nil {
+		movie.Overview = req.Overview
+	}
+	if req.ReleaseDate != nil {
+		movie.ReleaseDate = req.ReleaseDate
+	}
+	if req.PosterURL != nil {
+		movie.PosterURL = req.PosterURL
+	}
+	if req.BackdropURL != nil {
+		movie.BackdropURL = req.BackdropURL
+	}
+	if req.Genres != nil {
+		movie.Genres = req.Genres
+	}
+	if req.Runtime != nil {
+		if *req.Runtime < 0 {
+			return nil, fmt.Errorf("invalid runtime")
+		}
+		movie.Runtime = req.Runtime
+	}
+	if req.Adult != nil {
+		movie.Adult = *req.Adult
+	}
+
+	if err := uc.movieRepo.UpdateMovie(ctx, movie); err != nil {
+		return nil, err
+	}
+
+	return uc.movieToDTO(movie), nil
+}
+
+func (uc *UpdateMovieUseCase) movieToDTO(movie *domain.Movie) *dto.MovieDTO {
+	return &dto.MovieDTO{
+		ID:            movie.ID,
+		ExternalAPIID: movie.ExternalAPIID,
+		Title:         movie.Title,
+		Overview:      movie.Overview,
+		ReleaseDate:   movie.ReleaseDate,
+		PosterURL:     movie.PosterURL,
+		BackdropURL:   movie.BackdropURL,
+		Genres:        movie.Genres,
+		Runtime:       movie.Runtime,
+		VoteAverage:   movie.VoteAverage,
+		VoteCount:     movie.VoteCount,
+		Adult:         movie.Adult,
+		CreatedAt:     movie.CreatedAt,
+		UpdatedAt:     movie.UpdatedAt,
+	}
+}
@@ -0,0 +1,43 @@
+package admin
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/EduardoMG12/cine/api_v2/internal/domain"
+	"github.com/EduardoMG12/cine/api_v2/internal/dto"
+	"github.com/google/uuid"
+)
+
+type UpdateUserRoleUseCase struct {
+	userRepo domain.UserRepository
+}
+
+func NewUpdateUserRoleUseCase(userRepo domain.UserRepository) *UpdateUserRoleUseCase {
+	return &UpdateUserRoleUseCase{
+		userRepo: userRepo,
+	}
+}
+
+func (uc *UpdateUserRoleUseCase) Execute(ctx context.Context, actor *domain.User, targetID uuid.UUID, req *dto.UpdateUserRoleRequest) (*dto.AdminUserDTO, error) {
+	if !domain.IsValidRole(req.Role) {
+		return nil, fmt.Errorf("invalid role")
+	}
+
+	target, err := loadTarget(ctx, uc.userRepo, actor, targetID)
+	if err != nil {
+		return nil, err
+	}
+
+	if target.Role != req.Role {
+		if err := uc.userRepo.UpdateUserRole(ctx, target.ID, req.Role); err != nil {
+			return nil, err
+		}
+		log.Printf("[Admin] Role of user %s changed from %s to %s by %s", target.ID, target.Role, req.Role, actor.ID)
+		target.Role = req.Role
+	}
+
+	result := userToAdminDTO(target)
+	return &result, nil
+}
@@ -0,0 +1,65 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/EduardoMG12/cine/api_v2/internal/domain"
+	"github.com/EduardoMG12/cine/api_v2/internal/dto"
+	"github.com/EduardoMG12/cine/api_v2/internal/infrastructure"
+	"github.com/google/uuid"
+)
+
+type ConfirmTOTPUseCase struct {
+	totpRepo    domain.TOTPRepository
+	totpService *infrastructure.TOTPService
+	mfaVerifier *MFAVerifier
+}
+
+func NewConfirmTOTPUseCase(
+	totpRepo domain.TOTPRepository,
+	totpService *infrastructure.TOTPService,
+	mfaVerifier *MFAVerifier,
+) *ConfirmTOTPUseCase {
+	return &ConfirmTOTPUseCase{
+		totpRepo:    totpRepo,
+		totpService: totpService,
+		mfaVerifier: mfaVerifier,
+	}
+}
+
+// Execute enables 2FA once the user proves the authenticator app produces valid codes,
+// and returns the recovery codes. They are only shown this once.
+func (uc *ConfirmTOTPUseCase) Execute(ctx context.Context, userID uuid.UUID, input dto.TOTPCodeRequestDTO) (*dto.RecoveryCodesDTO, error) {
+	totp, err := uc.totpRepo.GetUserTOTP(ctx, userID)
+	if err != nil {
+		if err.Error() == "totp not found" {
+			return nil, fmt.Errorf("two-factor authentication not set up")
+		}
+		return nil, fmt.Errorf("failed to get two-factor settings: %w", err)
+	}
+	if totp.Enabled() {
+		return nil, fmt.Errorf("two-factor authentication already enabled")
+	}
+
+	step, ok := uc.totpService.Validate(totp.Secret, input.Code, time.Now())
+	if !ok {
+		return nil, fmt.Errorf("invalid code")
+	}
+
+	if _, err := uc.totpRepo.UseTOTPStep(ctx, userID, step); err != nil {
+		return nil, err
+	}
+
+	codes, err := uc.mfaVerifier.IssueRecoveryCodes(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.totpRepo.EnableUserTOTP(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	return &dto.RecoveryCodesDTO{RecoveryCodes: codes}, nil
+}
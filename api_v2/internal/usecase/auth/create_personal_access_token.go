@@ -0,0 +1,93 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/EduardoMG12/cine/api_v2/internal/domain"
+	"github.com/EduardoMG12/cine/api_v2/internal/dto"
+	"github.com/EduardoMG12/cine/api_v2/internal/infrastructure"
+	"github.com/google/uuid"
+)
+
+const (
+	personalAccessTokenBytes       = 32
+	personalAccessTokenPrefixChars = 8
+	maxPersonalAccessTokenName     = 100
+)
+
+type CreatePersonalAccessTokenUseCase struct {
+	tokenRepo domain.PersonalAccessTokenRepository
+}
+
+func NewCreatePersonalAccessTokenUseCase(tokenRepo domain.PersonalAccessTokenRepository) *CreatePersonalAccessTokenUseCase {
+	return &CreatePersonalAccessTokenUseCase{
+		tokenRepo: tokenRepo,
+	}
+}
+
+// Execute creates a personal access token. The token is only returned here, afterwards just its hash is known.
+func (uc *CreatePersonalAccessTokenUseCase) Execute(ctx context.Context, userID uuid.UUID, req *dto.CreatePersonalAccessTokenRequest) (*dto.CreatedPersonalAccessTokenDTO, error) {
+	name := strings.TrimSpace(req.Name)
+	if name == "" || len(name) > maxPersonalAccessTokenName {
+		return nil, fmt.Errorf("invalid token name")
+	}
+
+	scopes, err := normalizeScopes(req.Scopes)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.ExpiresAt != nil && !req.ExpiresAt.After(time.Now()) {
+		return nil, fmt.Errorf("expiration must be in the future")
+	}
+
+	secret, err := infrastructure.GenerateOpaqueToken(personalAccessTokenBytes)
+	if err != nil {
+		return nil, err
+	}
+	rawToken := domain.PersonalAccessTokenPrefix + secret
+
+	token := &domain.PersonalAccessToken{
+		UserID:      userID,
+		Name:        name,
+		TokenHash:   infrastructure.HashToken(rawToken),
+		TokenPrefix: rawToken[:len(domain.PersonalAccessTokenPrefix)+personalAccessTokenPrefixChars],
+		Scopes:      scopes,
+		ExpiresAt:   req.ExpiresAt,
+	}
+
+	if err := uc.tokenRepo.CreatePersonalAccessToken(ctx, token); err != nil {
+		return nil, err
+	}
+
+	return &dto.CreatedPersonalAccessTokenDTO{
+		PersonalAccessTokenDTO: personalAccessTokenToDTO(token),
+		Token:                  rawToken,
+	}, nil
+}
+
+// normalizeScopes rejects unknown scopes and drops duplicates
+func normalizeScopes(requested []string) ([]string, error) {
+	if len(requested) == 0 {
+		return nil, fmt.Errorf("invalid scope")
+	}
+
+	scopes := make([]string, 0, len(requested))
+	seen := make(map[string]bool, len(requested))
+	for _, scope := range requested {
+		scope = strings.TrimSpace(scope)
+		if !domain.IsValidScope(scope) {
+			return nil, fmt.Errorf("invalid scope")
+		}
+		if seen[scope] {
+			continue
+		}
+		seen[scope] = true
+		scopes = append(scopes, scope)
+	}
+
+	return scopes, nil
+}
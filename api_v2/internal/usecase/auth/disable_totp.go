@@ -0,0 +1,39 @@
+package auth
+
+import (
+	"context"
+
+	"github.com/EduardoMG12/cine/api_v2/internal/domain"
+	"github.com/EduardoMG12/cine/api_v2/internal/dto"
+	"github.com/google/uuid"
+)
+
+type DisableTOTPUseCase struct {
+	totpRepo         domain.TOTPRepository
+	recoveryCodeRepo domain.RecoveryCodeRepository
+	mfaVerifier      *MFAVerifier
+}
+
+func NewDisableTOTPUseCase(
+	totpRepo domain.TOTPRepository,
+	recoveryCodeRepo domain.RecoveryCodeRepository,
+	mfaVerifier *MFAVerifier,
+) *DisableTOTPUseCase {
+	return &DisableTOTPUseCase{
+		totpRepo:         totpRepo,
+		recoveryCodeRepo: recoveryCodeRepo,
+		mfaVerifier:      mfaVerifier,
+	}
+}
+
+func (uc *DisableTOTPUseCase) Execute(ctx context.Context, userID uuid.UUID, input dto.MFAReauthRequestDTO, metadata SessionMetadata) error {
+	if _, err := uc.mfaVerifier.Reauthenticate(ctx, userID, input.Password, input.Code, metadata); err != nil {
+		return err
+	}
+
+	if err := uc.totpRepo.DeleteUserTOTP(ctx, userID); err != nil {
+		return err
+	}
+
+	return uc.recoveryCodeRepo.DeleteUserRecoveryCodes(ctx, userID)
+}
@@ -0,0 +1,103 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/EduardoMG12/cine/api_v2/internal/domain"
+	"github.com/EduardoMG12/cine/api_v2/internal/dto"
+	"github.com/EduardoMG12/cine/api_v2/internal/infrastructure"
+)
+
+const passwordResetTokenBytes = 32
+
+type ForgotPasswordUseCase struct {
+	userRepo       domain.UserRepository
+	resetTokenRepo domain.PasswordResetTokenRepository
+	mailer         infrastructure.Mailer
+	appURL         string
+	tokenTTL       time.Duration
+	cooldown       time.Duration
+	hourlyLimit    int
+}
+
+func NewForgotPasswordUseCase(
+	userRepo domain.UserRepository,
+	resetTokenRepo domain.PasswordResetTokenRepository,
+	mailer infrastructure.Mailer,
+	appURL string,
+	tokenTTL time.Duration,
+	cooldown time.Duration,
+	hourlyLimit int,
+) *ForgotPasswordUseCase {
+	return &ForgotPasswordUseCase{
+		userRepo:       userRepo,
+		resetTokenRepo: resetTokenRepo,
+		mailer:         mailer,
+		appURL:         appURL,
+		tokenTTL:       tokenTTL,
+		cooldown:       cooldown,
+		hourlyLimit:    hourlyLimit,
+	}
+}
+
+// Execute sends a reset link when the email belongs to an account and it hasn't been
+// sent one too recently. It never reports whether the account exists or a mail was sent.
+func (uc *ForgotPasswordUseCase) Execute(ctx context.Context, input dto.ForgotPasswordRequestDTO) error {
+	email := strings.ToLower(strings.TrimSpace(input.Email))
+	if email == "" {
+		return fmt.Errorf("email is required")
+	}
+
+	user, err := uc.userRepo.GetUserByEmail(ctx, email)
+	if err != nil {
+		return nil
+	}
+
+	recent, err := uc.resetTokenRepo.GetUserPasswordResetTokensSince(ctx, user.ID, time.Now().Add(-time.Hour))
+	if err != nil {
+		return fmt.Errorf("failed to check password reset rate limit: %w", err)
+	}
+
+	if len(recent) >= uc.hourlyLimit {
+		return nil
+	}
+	if len(recent) > 0 && time.Since(recent[0].CreatedAt) < uc.cooldown {
+		return nil
+	}
+
+	token, err := infrastructure.GenerateOpaqueToken(passwordResetTokenBytes)
+	if err != nil {
+		return err
+	}
+
+	resetToken := &domain.PasswordResetToken{
+		UserID:    user.ID,
+		TokenHash: infrastructure.HashToken(token),
+		ExpiresAt: time.Now().Add(uc.tokenTTL),
+	}
+
+	if err := uc.resetTokenRepo.CreatePasswordResetToken(ctx, resetToken); err != nil {
+		return fmt.Errorf("failed to create password reset token: %w", err)
+	}
+
+	link := fmt.Sprintf("%s/reset-password?token=%s", strings.TrimRight(uc.appURL, "/"), url.QueryEscape(token))
+	message := infrastructure.EmailMessage{
+		To:      user.Email,
+		Subject: "Reset your CineVerse password",
+		Body: fmt.Sprintf(
+			"Hi %s,\n\nWe received a request to reset your password. Use the link below to choose a new one:\n\n%s\n\nThe link expires in %s and can only be used once. If you didn't ask for this, you can ignore this email.\n",
+			user.DisplayName, link, uc.tokenTTL,
+		),
+	}
+
+	if err := uc.mailer.Send(message); err != nil {
+		log.Printf("[Auth] Failed to send password reset email to user %s: %v", user.ID, err)
+	}
+
+	return nil
+}
@@ -1,6 +1,7 @@
 package auth
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/EduardoMG12/cine/api_v2/internal/domain"
@@ -18,8 +19,8 @@ func NewGetMeUseCase(userRepo domain.UserRepository) *GetMeUseCase {
 	}
 }
 
-func (uc *GetMeUseCase) Execute(userID uuid.UUID) (*dto.UserDTO, error) {
-	user, err := uc.userRepo.GetUserByID(userID)
+func (uc *GetMeUseCase) Execute(ctx context.Context, userID uuid.UUID) (*dto.UserDTO, error) {
+	user, err := uc.userRepo.GetUserByID(ctx, userID)
 	if err != nil {
 		return nil, fmt.Errorf("user not found")
 	}
@@ -39,6 +40,7 @@ func (uc *GetMeUseCase) userToDTO(user *domain.User) dto.UserDTO {
 		IsPrivate:         user.IsPrivate,
 		EmailVerified:     user.EmailVerified,
 		Theme:             user.Theme,
+		Role:              user.Role,
 		CreatedAt:         user.CreatedAt,
 		UpdatedAt:         user.UpdatedAt,
 	}
@@ -0,0 +1,46 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/EduardoMG12/cine/api_v2/internal/domain"
+	"github.com/EduardoMG12/cine/api_v2/internal/dto"
+	"github.com/google/uuid"
+)
+
+type ListPersonalAccessTokensUseCase struct {
+	tokenRepo domain.PersonalAccessTokenRepository
+}
+
+func NewListPersonalAccessTokensUseCase(tokenRepo domain.PersonalAccessTokenRepository) *ListPersonalAccessTokensUseCase {
+	return &ListPersonalAccessTokensUseCase{
+		tokenRepo: tokenRepo,
+	}
+}
+
+func (uc *ListPersonalAccessTokensUseCase) Execute(ctx context.Context, userID uuid.UUID) ([]dto.PersonalAccessTokenDTO, error) {
+	tokens, err := uc.tokenRepo.GetUserPersonalAccessTokens(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list personal access tokens: %w", err)
+	}
+
+	result := make([]dto.PersonalAccessTokenDTO, 0, len(tokens))
+	for i := range tokens {
+		result = append(result, personalAccessTokenToDTO(&tokens[i]))
+	}
+
+	return result, nil
+}
+
+func personalAccessTokenToDTO(token *domain.PersonalAccessToken) dto.PersonalAccessTokenDTO {
+	return dto.PersonalAccessTokenDTO{
+		ID:          token.ID,
+		Name:        token.Name,
+		TokenPrefix: token.TokenPrefix,
+		Scopes:      token.Scopes,
+		ExpiresAt:   token.ExpiresAt,
+		LastUsedAt:  token.LastUsedAt,
+		CreatedAt:   token.CreatedAt,
+	}
+}
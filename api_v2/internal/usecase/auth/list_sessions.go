@@ -0,0 +1,42 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/EduardoMG12/cine/api_v2/internal/domain"
+	"github.com/EduardoMG12/cine/api_v2/internal/dto"
+	"github.com/google/uuid"
+)
+
+type ListSessionsUseCase struct {
+	sessionRepo domain.SessionRepository
+}
+
+func NewListSessionsUseCase(sessionRepo domain.SessionRepository) *ListSessionsUseCase {
+	return &ListSessionsUseCase{
+		sessionRepo: sessionRepo,
+	}
+}
+
+func (uc *ListSessionsUseCase) Execute(ctx context.Context, userID, currentSessionID uuid.UUID) ([]dto.SessionDTO, error) {
+	sessions, err := uc.sessionRepo.GetUserSessions(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list sessions: %w", err)
+	}
+
+	result := make([]dto.SessionDTO, 0, len(sessions))
+	for _, session := range sessions {
+		result = append(result, dto.SessionDTO{
+			ID:         session.ID,
+			UserAgent:  session.UserAgent,
+			IPAddress:  session.IPAddress,
+			CreatedAt:  session.CreatedAt,
+			LastUsedAt: session.LastUsedAt,
+			ExpiresAt:  session.ExpiresAt,
+			Current:    session.ID == currentSessionID,
+		})
+	}
+
+	return result, nil
+}
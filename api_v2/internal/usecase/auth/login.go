@@ -1,6 +1,7 @@
 package auth
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"time"
@@ -10,55 +11,120 @@ import (
 	"github.com/EduardoMG12/cine/api_v2/internal/infrastructure"
 )
 
+const mfaChallengeTokenBytes = 32
+
 type LoginUseCase struct {
-	userRepo        domain.UserRepository
-	sessionRepo     domain.SessionRepository
-	passwordService *infrastructure.PasswordService
-	jwtService      *infrastructure.JWTService
+	userRepo         domain.UserRepository
+	passwordService  *infrastructure.PasswordService
+	sessionIssuer    *SessionIssuer
+	mfaVerifier      *MFAVerifier
+	mfaChallengeRepo domain.MFAChallengeRepository
+	mfaChallengeTTL  time.Duration
+	loginGuard       *LoginGuard
 }
 
 func NewLoginUseCase(
 	userRepo domain.UserRepository,
-	sessionRepo domain.SessionRepository,
 	passwordService *infrastructure.PasswordService,
-	jwtService *infrastructure.JWTService,
+	sessionIssuer *SessionIssuer,
+	mfaVerifier *MFAVerifier,
+	mfaChallengeRepo domain.MFAChallengeRepository,
+	mfaChallengeTTL time.Duration,
+	loginGuard *LoginGuard,
 ) *LoginUseCase {
 	return &LoginUseCase{
-		userRepo:        userRepo,
-		sessionRepo:     sessionRepo,
-		passwordService: passwordService,
-		jwtService:      jwtService,
+		userRepo:         userRepo,
+		passwordService:  passwordService,
+		sessionIssuer:    sessionIssuer,
+		mfaVerifier:      mfaVerifier,
+		mfaChallengeRepo: mfaChallengeRepo,
+		mfaChallengeTTL:  mfaChallengeTTL,
+		loginGuard:       loginGuard,
 	}
 }
 
-func (uc *LoginUseCase) Execute(input dto.LoginRequestDTO) (*dto.AuthResponseDTO, error) {
-	user, err := uc.userRepo.GetUserByEmail(strings.ToLower(input.Email))
+// Execute checks the credentials and starts a session.
+// When 2FA is enabled no session is created: a challenge is returned instead and
+// the session is issued by VerifyMFALoginUseCase once the second factor is checked, and
+// the failures of the email are only cleared then.
+// Returns a *infrastructure.TooManyAttemptsError while the email or IP address is locked out.
+func (uc *LoginUseCase) Execute(ctx context.Context, input dto.LoginRequestDTO, metadata SessionMetadata) (*dto.AuthResponseDTO, *dto.MFAChallengeDTO, error) {
+	email := strings.ToLower(input.Email)
+
+	if err := uc.loginGuard.Check(ctx, email, metadata); err != nil {
+		return nil, nil, err
+	}
+
+	user, err := uc.userRepo.GetUserByEmail(ctx, email)
 	if err != nil {
-		return nil, fmt.Errorf("invalid credentials")
+		uc.loginGuard.RegisterFailure(ctx, email, metadata)
+		return nil, nil, fmt.Errorf("invalid credentials")
 	}
 
 	if !uc.passwordService.ComparePassword(user.PasswordHash, input.Password) {
-		return nil, fmt.Errorf("invalid credentials")
+		uc.loginGuard.RegisterFailure(ctx, email, metadata)
+		return nil, nil, fmt.Errorf("invalid credentials")
+	}
+
+	if user.Disabled() {
+		return nil, nil, fmt.Errorf("account disabled")
 	}
 
-	token, err := uc.jwtService.GenerateToken(user.ID)
+	totp, err := uc.mfaVerifier.EnabledTOTP(ctx, user.ID)
 	if err != nil {
-		return nil, fmt.Errorf("failed to generate token: %w", err)
+		return nil, nil, fmt.Errorf("failed to get two-factor settings: %w", err)
+	}
+	if totp != nil {
+		challenge, err := uc.createChallenge(ctx, user)
+		if err != nil {
+			return nil, nil, err
+		}
+		return nil, challenge, nil
 	}
 
-	session := &domain.UserSession{
-		UserID:    user.ID,
-		Token:     token,
-		ExpiresAt: time.Now().Add(24 * time.Hour),
+	uc.loginGuard.RegisterSuccess(ctx, email)
+
+	// Logging in during the deletion grace period keeps the account
+	if user.DeletionScheduledAt != nil {
+		if err := uc.userRepo.CancelUserDeletion(ctx, user.ID); err != nil {
+			return nil, nil, err
+		}
+		user.DeletionScheduledAt = nil
 	}
 
-	if err := uc.sessionRepo.CreateSession(session); err != nil {
-		return nil, fmt.Errorf("failed to create session: %w", err)
+	issued, err := uc.sessionIssuer.Issue(ctx, user, metadata)
+	if err != nil {
+		return nil, nil, err
 	}
 
 	return &dto.AuthResponseDTO{
-		Token: token,
-		User:  uc.userToDTO(user),
+		Token:        issued.AccessToken,
+		RefreshToken: issued.RefreshToken,
+		ExpiresIn:    issued.ExpiresIn,
+		User:         uc.userToDTO(user),
+	}, nil, nil
+}
+
+func (uc *LoginUseCase) createChallenge(ctx context.Context, user *domain.User) (*dto.MFAChallengeDTO, error) {
+	token, err := infrastructure.GenerateOpaqueToken(mfaChallengeTokenBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	challenge := &domain.MFAChallenge{
+		UserID:    user.ID,
+		TokenHash: infrastructure.HashToken(token),
+		ExpiresAt: time.Now().Add(uc.mfaChallengeTTL),
+	}
+
+	if err := uc.mfaChallengeRepo.CreateMFAChallenge(ctx, challenge); err != nil {
+		return nil, err
+	}
+
+	return &dto.MFAChallengeDTO{
+		MFARequired: true,
+		MFAToken:    token,
+		ExpiresIn:   int64(uc.mfaChallengeTTL.Seconds()),
 	}, nil
 }
 
@@ -73,6 +139,7 @@ func (uc *LoginUseCase) userToDTO(user *domain.User) dto.UserDTO {
 		IsPrivate:         user.IsPrivate,
 		EmailVerified:     user.EmailVerified,
 		Theme:             user.Theme,
+		Role:              user.Role,
 		CreatedAt:         user.CreatedAt,
 		UpdatedAt:         user.UpdatedAt,
 	}
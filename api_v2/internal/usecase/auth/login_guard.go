@@ -0,0 +1,62 @@
+package auth
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/EduardoMG12/cine/api_v2/internal/domain"
+	"github.com/EduardoMG12/cine/api_v2/internal/infrastructure"
+)
+
+// LoginGuard throttles every step that checks a credential of a user.
+// Wrong passwords, second factor codes and re-authentications all count towards
+// the same per email and per IP lockout, so a known password can't be used to
+// retry codes forever.
+type LoginGuard struct {
+	throttler   *infrastructure.LoginThrottler
+	lockoutRepo domain.LoginLockoutRepository
+}
+
+func NewLoginGuard(throttler *infrastructure.LoginThrottler, lockoutRepo domain.LoginLockoutRepository) *LoginGuard {
+	return &LoginGuard{
+		throttler:   throttler,
+		lockoutRepo: lockoutRepo,
+	}
+}
+
+// Check returns a *infrastructure.TooManyAttemptsError while the email or IP address is locked out
+func (g *LoginGuard) Check(ctx context.Context, email string, metadata SessionMetadata) error {
+	return g.throttler.Check(ctx, email, metadata.IPAddress)
+}
+
+// RegisterFailure counts a failed credential check and records the lockouts it started
+func (g *LoginGuard) RegisterFailure(ctx context.Context, email string, metadata SessionMetadata) {
+	for _, lockout := range g.throttler.RegisterFailure(ctx, email, metadata.IPAddress) {
+		log.Printf("[Auth] Login locked for %s %s until %s after %d failures",
+			lockout.Scope, lockout.Identifier, lockout.LockedUntil.Format(time.RFC3339), lockout.Failures)
+
+		record := &domain.LoginLockout{
+			Scope:       lockout.Scope,
+			Identifier:  lockout.Identifier,
+			Failures:    int(lockout.Failures),
+			LockedUntil: lockout.LockedUntil,
+		}
+		if metadata.IPAddress != "" {
+			record.IPAddress = &metadata.IPAddress
+		}
+		if metadata.UserAgent != "" {
+			record.UserAgent = &metadata.UserAgent
+		}
+
+		if err := g.lockoutRepo.CreateLoginLockout(ctx, record); err != nil {
+			log.Printf("[Auth] Failed to record login lockout: %v", err)
+		}
+	}
+}
+
+// RegisterSuccess clears the failures of an email.
+// Only call it once every factor of the user has been checked.
+func (g *LoginGuard) RegisterSuccess(ctx context.Context, email string) {
+	g.throttler.RegisterSuccess(ctx, email)
+}
@@ -1,24 +1,35 @@
 package auth
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/EduardoMG12/cine/api_v2/internal/domain"
+	"github.com/EduardoMG12/cine/api_v2/internal/infrastructure"
 )
 
 type LogoutUseCase struct {
-	sessionRepo domain.SessionRepository
+	sessionRepo  domain.SessionRepository
+	sessionCache *infrastructure.SessionCache
 }
 
-func NewLogoutUseCase(sessionRepo domain.SessionRepository) *LogoutUseCase {
+func NewLogoutUseCase(sessionRepo domain.SessionRepository, sessionCache *infrastructure.SessionCache) *LogoutUseCase {
 	return &LogoutUseCase{
-		sessionRepo: sessionRepo,
+		sessionRepo:  sessionRepo,
+		sessionCache: sessionCache,
 	}
 }
 
-func (uc *LogoutUseCase) Execute(token string) error {
-	if err := uc.sessionRepo.DeleteSession(token); err != nil {
+func (uc *LogoutUseCase) Execute(ctx context.Context, token string) error {
+	session, err := uc.sessionRepo.GetSessionByToken(ctx, token)
+	if err != nil {
 		return fmt.Errorf("failed to logout: %w", err)
 	}
+
+	if err := uc.sessionRepo.DeleteSession(ctx, token); err != nil {
+		return fmt.Errorf("failed to logout: %w", err)
+	}
+
+	uc.sessionCache.Revoke(ctx, token, session.ExpiresAt)
 	return nil
 }
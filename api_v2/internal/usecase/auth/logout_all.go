@@ -1,25 +1,36 @@
 package auth
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/EduardoMG12/cine/api_v2/internal/domain"
+	"github.com/EduardoMG12/cine/api_v2/internal/infrastructure"
 	"github.com/google/uuid"
 )
 
 type LogoutAllUseCase struct {
-	sessionRepo domain.SessionRepository
+	sessionRepo  domain.SessionRepository
+	sessionCache *infrastructure.SessionCache
 }
 
-func NewLogoutAllUseCase(sessionRepo domain.SessionRepository) *LogoutAllUseCase {
+func NewLogoutAllUseCase(sessionRepo domain.SessionRepository, sessionCache *infrastructure.SessionCache) *LogoutAllUseCase {
 	return &LogoutAllUseCase{
-		sessionRepo: sessionRepo,
+		sessionRepo:  sessionRepo,
+		sessionCache: sessionCache,
 	}
 }
 
-func (uc *LogoutAllUseCase) Execute(userID uuid.UUID) error {
-	if err := uc.sessionRepo.DeleteUserSessions(userID); err != nil {
+func (uc *LogoutAllUseCase) Execute(ctx context.Context, userID uuid.UUID) error {
+	sessions, err := uc.sessionRepo.GetUserSessions(ctx, userID)
+	if err != nil {
 		return fmt.Errorf("failed to logout from all sessions: %w", err)
 	}
+
+	if err := uc.sessionRepo.DeleteUserSessions(ctx, userID); err != nil {
+		return fmt.Errorf("failed to logout from all sessions: %w", err)
+	}
+
+	uc.sessionCache.RevokeSessions(ctx, sessions)
 	return nil
 }
@@ -0,0 +1,168 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/EduardoMG12/cine/api_v2/internal/domain"
+	"github.com/EduardoMG12/cine/api_v2/internal/infrastructure"
+	"github.com/google/uuid"
+)
+
+const (
+	recoveryCodeCount  = 10
+	recoveryCodeLength = 10
+	// Unambiguous lowercase alphabet for recovery codes (no 0/o, 1/l/i)
+	recoveryCodeAlphabet = "abcdefghjkmnpqrstuvwxyz23456789"
+)
+
+// MFAVerifier checks second factor codes and re-authenticates users before sensitive 2FA changes
+type MFAVerifier struct {
+	userRepo         domain.UserRepository
+	totpRepo         domain.TOTPRepository
+	recoveryCodeRepo domain.RecoveryCodeRepository
+	passwordService  *infrastructure.PasswordService
+	totpService      *infrastructure.TOTPService
+	loginGuard       *LoginGuard
+}
+
+func NewMFAVerifier(
+	userRepo domain.UserRepository,
+	totpRepo domain.TOTPRepository,
+	recoveryCodeRepo domain.RecoveryCodeRepository,
+	passwordService *infrastructure.PasswordService,
+	totpService *infrastructure.TOTPService,
+	loginGuard *LoginGuard,
+) *MFAVerifier {
+	return &MFAVerifier{
+		userRepo:         userRepo,
+		totpRepo:         totpRepo,
+		recoveryCodeRepo: recoveryCodeRepo,
+		passwordService:  passwordService,
+		totpService:      totpService,
+		loginGuard:       loginGuard,
+	}
+}
+
+// EnabledTOTP returns the TOTP enrollment of a user, or nil when 2FA is not enabled
+func (v *MFAVerifier) EnabledTOTP(ctx context.Context, userID uuid.UUID) (*domain.UserTOTP, error) {
+	totp, err := v.totpRepo.GetUserTOTP(ctx, userID)
+	if err != nil {
+		if err.Error() == "totp not found" {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	if !totp.Enabled() {
+		return nil, nil
+	}
+
+	return totp, nil
+}
+
+// Verify accepts either a current TOTP code or an unused recovery code.
+// Both are single use.
+func (v *MFAVerifier) Verify(ctx context.Context, totp *domain.UserTOTP, code string) (bool, error) {
+	if step, ok := v.totpService.Validate(totp.Secret, code, time.Now()); ok {
+		return v.totpRepo.UseTOTPStep(ctx, totp.UserID, step)
+	}
+
+	normalized := normalizeRecoveryCode(code)
+	if len(normalized) != recoveryCodeLength {
+		return false, nil
+	}
+
+	return v.recoveryCodeRepo.UseRecoveryCode(ctx, totp.UserID, infrastructure.HashToken(normalized))
+}
+
+// Reauthenticate checks the password and a second factor code of a user with 2FA enabled.
+// Failures count towards the login lockout of the user, and a locked out user gets a
+// *infrastructure.TooManyAttemptsError.
+func (v *MFAVerifier) Reauthenticate(ctx context.Context, userID uuid.UUID, password, code string, metadata SessionMetadata) (*domain.UserTOTP, error) {
+	user, err := v.userRepo.GetUserByID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("user not found")
+	}
+	email := strings.ToLower(user.Email)
+
+	if err := v.loginGuard.Check(ctx, email, metadata); err != nil {
+		return nil, err
+	}
+
+	if !v.passwordService.ComparePassword(user.PasswordHash, password) {
+		v.loginGuard.RegisterFailure(ctx, email, metadata)
+		return nil, fmt.Errorf("invalid credentials")
+	}
+
+	totp, err := v.EnabledTOTP(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get two-factor settings: %w", err)
+	}
+	if totp == nil {
+		return nil, fmt.Errorf("two-factor authentication not enabled")
+	}
+
+	valid, err := v.Verify(ctx, totp, code)
+	if err != nil {
+		return nil, fmt.Errorf("failed to verify code: %w", err)
+	}
+	if !valid {
+		v.loginGuard.RegisterFailure(ctx, email, metadata)
+		return nil, fmt.Errorf("invalid code")
+	}
+
+	v.loginGuard.RegisterSuccess(ctx, email)
+
+	return totp, nil
+}
+
+// IssueRecoveryCodes replaces the recovery codes of a user and returns the new plain codes
+func (v *MFAVerifier) IssueRecoveryCodes(ctx context.Context, userID uuid.UUID) ([]string, error) {
+	codes := make([]string, 0, recoveryCodeCount)
+	hashes := make([]string, 0, recoveryCodeCount)
+
+	for i := 0; i < recoveryCodeCount; i++ {
+		code, err := generateRecoveryCode()
+		if err != nil {
+			return nil, err
+		}
+		codes = append(codes, code[:recoveryCodeLength/2]+"-"+code[recoveryCodeLength/2:])
+		hashes = append(hashes, infrastructure.HashToken(code))
+	}
+
+	if err := v.recoveryCodeRepo.ReplaceUserRecoveryCodes(ctx, userID, hashes); err != nil {
+		return nil, fmt.Errorf("failed to store recovery codes: %w", err)
+	}
+
+	return codes, nil
+}
+
+func generateRecoveryCode() (string, error) {
+	// Bytes above the largest multiple of the alphabet size are dropped to avoid modulo bias
+	limit := 256 - 256%len(recoveryCodeAlphabet)
+
+	code := make([]byte, 0, recoveryCodeLength)
+	buf := make([]byte, recoveryCodeLength)
+	for len(code) < recoveryCodeLength {
+		if _, err := rand.Read(buf); err != nil {
+			return "", fmt.Errorf("failed to generate recovery code: %w", err)
+		}
+		for _, b := range buf {
+			if int(b) < limit && len(code) < recoveryCodeLength {
+				code = append(code, recoveryCodeAlphabet[int(b)%len(recoveryCodeAlphabet)])
+			}
+		}
+	}
+
+	return string(code), nil
+}
+
+func normalizeRecoveryCode(code string) string {
+	code = strings.ToLower(strings.TrimSpace(code))
+	code = strings.ReplaceAll(code, "-", "")
+	return strings.ReplaceAll(code, " ", "")
+}
@@ -0,0 +1,129 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/EduardoMG12/cine/api_v2/internal/domain"
+	"github.com/EduardoMG12/cine/api_v2/internal/dto"
+	"github.com/EduardoMG12/cine/api_v2/internal/infrastructure"
+	"github.com/google/uuid"
+)
+
+type RefreshTokenUseCase struct {
+	userRepo         domain.UserRepository
+	sessionRepo      domain.SessionRepository
+	refreshTokenRepo domain.RefreshTokenRepository
+	sessionIssuer    *SessionIssuer
+	sessionCache     *infrastructure.SessionCache
+}
+
+func NewRefreshTokenUseCase(
+	userRepo domain.UserRepository,
+	sessionRepo domain.SessionRepository,
+	refreshTokenRepo domain.RefreshTokenRepository,
+	sessionIssuer *SessionIssuer,
+	sessionCache *infrastructure.SessionCache,
+) *RefreshTokenUseCase {
+	return &RefreshTokenUseCase{
+		userRepo:         userRepo,
+		sessionRepo:      sessionRepo,
+		refreshTokenRepo: refreshTokenRepo,
+		sessionIssuer:    sessionIssuer,
+		sessionCache:     sessionCache,
+	}
+}
+
+func (uc *RefreshTokenUseCase) Execute(ctx context.Context, input dto.RefreshTokenRequestDTO) (*dto.AuthResponseDTO, error) {
+	if input.RefreshToken == "" {
+		return nil, fmt.Errorf("invalid refresh token")
+	}
+
+	stored, err := uc.refreshTokenRepo.GetRefreshTokenByHash(ctx, infrastructure.HashToken(input.RefreshToken))
+	if err != nil {
+		return nil, fmt.Errorf("invalid refresh token")
+	}
+
+	// A used token coming back means it leaked: kill the whole family
+	if stored.UsedAt != nil {
+		uc.revokeFamily(ctx, stored.SessionID)
+		return nil, fmt.Errorf("refresh token reuse detected")
+	}
+
+	if time.Now().After(stored.ExpiresAt) {
+		return nil, fmt.Errorf("invalid refresh token")
+	}
+
+	marked, err := uc.refreshTokenRepo.MarkRefreshTokenUsed(ctx, stored.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to rotate refresh token: %w", err)
+	}
+	if !marked {
+		// Lost a race against another request presenting the same token
+		uc.revokeFamily(ctx, stored.SessionID)
+		return nil, fmt.Errorf("refresh token reuse detected")
+	}
+
+	session, err := uc.sessionRepo.GetSessionByID(ctx, stored.SessionID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid refresh token")
+	}
+
+	user, err := uc.userRepo.GetUserByID(ctx, session.UserID)
+	if err != nil || user.Disabled() {
+		return nil, fmt.Errorf("invalid refresh token")
+	}
+
+	previousToken := session.Token
+	previousExpiresAt := session.ExpiresAt
+
+	issued, err := uc.sessionIssuer.Rotate(ctx, session, user)
+	if err != nil {
+		return nil, err
+	}
+
+	uc.sessionCache.Revoke(ctx, previousToken, previousExpiresAt)
+
+	return &dto.AuthResponseDTO{
+		Token:        issued.AccessToken,
+		RefreshToken: issued.RefreshToken,
+		ExpiresIn:    issued.ExpiresIn,
+		User:         uc.userToDTO(user),
+	}, nil
+}
+
+func (uc *RefreshTokenUseCase) revokeFamily(ctx context.Context, sessionID uuid.UUID) {
+	session, err := uc.sessionRepo.GetSessionByID(ctx, sessionID)
+	if err != nil {
+		// Session already gone, nothing left to revoke
+		return
+	}
+
+	log.Printf("[Auth] Refresh token reuse detected, revoking session %s of user %s", session.ID, session.UserID)
+
+	if err := uc.sessionRepo.DeleteSessionByID(ctx, session.ID); err != nil {
+		log.Printf("[Auth] Failed to revoke session %s: %v", session.ID, err)
+		return
+	}
+
+	uc.sessionCache.Revoke(ctx, session.Token, session.ExpiresAt)
+}
+
+func (uc *RefreshTokenUseCase) userToDTO(user *domain.User) dto.UserDTO {
+	return dto.UserDTO{
+		ID:                user.ID,
+		Username:          user.Username,
+		Email:             user.Email,
+		DisplayName:       user.DisplayName,
+		Bio:               user.Bio,
+		ProfilePictureURL: user.ProfilePictureURL,
+		IsPrivate:         user.IsPrivate,
+		EmailVerified:     user.EmailVerified,
+		Theme:             user.Theme,
+		Role:              user.Role,
+		CreatedAt:         user.CreatedAt,
+		UpdatedAt:         user.UpdatedAt,
+	}
+}
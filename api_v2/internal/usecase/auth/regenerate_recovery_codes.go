@@ -0,0 +1,32 @@
+package auth
+
+import (
+	"context"
+
+	"github.com/EduardoMG12/cine/api_v2/internal/dto"
+	"github.com/google/uuid"
+)
+
+type RegenerateRecoveryCodesUseCase struct {
+	mfaVerifier *MFAVerifier
+}
+
+func NewRegenerateRecoveryCodesUseCase(mfaVerifier *MFAVerifier) *RegenerateRecoveryCodesUseCase {
+	return &RegenerateRecoveryCodesUseCase{
+		mfaVerifier: mfaVerifier,
+	}
+}
+
+// Execute invalidates every previous recovery code and returns a new set
+func (uc *RegenerateRecoveryCodesUseCase) Execute(ctx context.Context, userID uuid.UUID, input dto.MFAReauthRequestDTO, metadata SessionMetadata) (*dto.RecoveryCodesDTO, error) {
+	if _, err := uc.mfaVerifier.Reauthenticate(ctx, userID, input.Password, input.Code, metadata); err != nil {
+		return nil, err
+	}
+
+	codes, err := uc.mfaVerifier.IssueRecoveryCodes(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.RecoveryCodesDTO{RecoveryCodes: codes}, nil
+}
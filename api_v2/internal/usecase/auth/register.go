@@ -1,54 +1,73 @@
 package auth
 
 import (
+	"context"
 	"fmt"
+	"log"
 	"strings"
-	"time"
 
 	"github.com/EduardoMG12/cine/api_v2/internal/domain"
 	"github.com/EduardoMG12/cine/api_v2/internal/dto"
 	"github.com/EduardoMG12/cine/api_v2/internal/infrastructure"
+	"github.com/google/uuid"
 )
 
 type RegisterUseCase struct {
-	userRepo        domain.UserRepository
-	sessionRepo     domain.SessionRepository
-	passwordService *infrastructure.PasswordService
-	jwtService      *infrastructure.JWTService
+	userRepo           domain.UserRepository
+	passwordService    *infrastructure.PasswordService
+	sessionIssuer      *SessionIssuer
+	verificationIssuer *VerificationIssuer
 }
 
 func NewRegisterUseCase(
 	userRepo domain.UserRepository,
-	sessionRepo domain.SessionRepository,
 	passwordService *infrastructure.PasswordService,
-	jwtService *infrastructure.JWTService,
+	sessionIssuer *SessionIssuer,
+	verificationIssuer *VerificationIssuer,
 ) *RegisterUseCase {
 	return &RegisterUseCase{
-		userRepo:        userRepo,
-		sessionRepo:     sessionRepo,
-		passwordService: passwordService,
-		jwtService:      jwtService,
+		userRepo:           userRepo,
+		passwordService:    passwordService,
+		sessionIssuer:      sessionIssuer,
+		verificationIssuer: verificationIssuer,
 	}
 }
 
-func (uc *RegisterUseCase) Execute(input dto.RegisterRequestDTO) (*dto.AuthResponseDTO, error) {
-	existingUser, _ := uc.userRepo.GetUserByEmail(strings.ToLower(input.Email))
+func (uc *RegisterUseCase) Execute(ctx context.Context, input dto.RegisterRequestDTO, metadata SessionMetadata) (*dto.AuthResponseDTO, error) {
+	existingUser, _ := uc.userRepo.GetUserByEmail(ctx, strings.ToLower(input.Email))
 	if existingUser != nil {
 		return nil, fmt.Errorf("email already registered")
 	}
 
-	existingUser, _ = uc.userRepo.GetUserByUsername(strings.ToLower(input.Username))
+	username := domain.NormalizeUsername(input.Username)
+	if err := domain.ValidateUsername(username); err != nil {
+		return nil, err
+	}
+
+	existingUser, _ = uc.userRepo.GetUserByUsername(ctx, username)
 	if existingUser != nil {
 		return nil, fmt.Errorf("username already taken")
 	}
 
+	reserved, err := uc.userRepo.IsUsernameReserved(ctx, username, uuid.Nil)
+	if err != nil {
+		return nil, err
+	}
+	if reserved {
+		return nil, fmt.Errorf("username reserved")
+	}
+
+	if err := uc.passwordService.ValidateStrength(input.Password); err != nil {
+		return nil, err
+	}
+
 	hashedPassword, err := uc.passwordService.HashPassword(input.Password)
 	if err != nil {
 		return nil, fmt.Errorf("failed to hash password: %w", err)
 	}
 
 	user := &domain.User{
-		Username:      strings.ToLower(input.Username),
+		Username:      username,
 		Email:         strings.ToLower(input.Email),
 		DisplayName:   input.DisplayName,
 		PasswordHash:  hashedPassword,
@@ -57,28 +76,25 @@ func (uc *RegisterUseCase) Execute(input dto.RegisterRequestDTO) (*dto.AuthRespo
 		Theme:         "light",
 	}
 
-	if err := uc.userRepo.CreateUser(user); err != nil {
+	if err := uc.userRepo.CreateUser(ctx, user); err != nil {
 		return nil, fmt.Errorf("failed to create user: %w", err)
 	}
 
-	token, err := uc.jwtService.GenerateToken(user.ID)
-	if err != nil {
-		return nil, fmt.Errorf("failed to generate token: %w", err)
-	}
-
-	session := &domain.UserSession{
-		UserID:    user.ID,
-		Token:     token,
-		ExpiresAt: time.Now().Add(24 * time.Hour),
+	// The account is usable right away; the user can ask for a new link if this one gets lost
+	if err := uc.verificationIssuer.Issue(ctx, user); err != nil {
+		log.Printf("[Auth] Failed to send verification email to user %s: %v", user.ID, err)
 	}
 
-	if err := uc.sessionRepo.CreateSession(session); err != nil {
-		return nil, fmt.Errorf("failed to create session: %w", err)
+	issued, err := uc.sessionIssuer.Issue(ctx, user, metadata)
+	if err != nil {
+		return nil, err
 	}
 
 	return &dto.AuthResponseDTO{
-		Token: token,
-		User:  uc.userToDTO(user),
+		Token:        issued.AccessToken,
+		RefreshToken: issued.RefreshToken,
+		ExpiresIn:    issued.ExpiresIn,
+		User:         uc.userToDTO(user),
 	}, nil
 }
 
@@ -93,6 +109,7 @@ func (uc *RegisterUseCase) userToDTO(user *domain.User) dto.UserDTO {
 		IsPrivate:         user.IsPrivate,
 		EmailVerified:     user.EmailVerified,
 		Theme:             user.Theme,
+		Role:              user.Role,
 		CreatedAt:         user.CreatedAt,
 		UpdatedAt:         user.UpdatedAt,
 	}
@@ -0,0 +1,59 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/EduardoMG12/cine/api_v2/internal/domain"
+	"github.com/google/uuid"
+)
+
+type ResendVerificationUseCase struct {
+	userRepo           domain.UserRepository
+	tokenRepo          domain.EmailVerificationTokenRepository
+	verificationIssuer *VerificationIssuer
+	cooldown           time.Duration
+	hourlyLimit        int
+}
+
+func NewResendVerificationUseCase(
+	userRepo domain.UserRepository,
+	tokenRepo domain.EmailVerificationTokenRepository,
+	verificationIssuer *VerificationIssuer,
+	cooldown time.Duration,
+	hourlyLimit int,
+) *ResendVerificationUseCase {
+	return &ResendVerificationUseCase{
+		userRepo:           userRepo,
+		tokenRepo:          tokenRepo,
+		verificationIssuer: verificationIssuer,
+		cooldown:           cooldown,
+		hourlyLimit:        hourlyLimit,
+	}
+}
+
+func (uc *ResendVerificationUseCase) Execute(ctx context.Context, userID uuid.UUID) error {
+	user, err := uc.userRepo.GetUserByID(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("user not found")
+	}
+
+	if user.EmailVerified {
+		return fmt.Errorf("email already verified")
+	}
+
+	recent, err := uc.tokenRepo.GetUserEmailVerificationTokensSince(ctx, user.ID, time.Now().Add(-time.Hour))
+	if err != nil {
+		return fmt.Errorf("failed to check verification rate limit: %w", err)
+	}
+
+	if len(recent) >= uc.hourlyLimit {
+		return fmt.Errorf("too many verification requests")
+	}
+	if len(recent) > 0 && time.Since(recent[0].CreatedAt) < uc.cooldown {
+		return fmt.Errorf("too many verification requests")
+	}
+
+	return uc.verificationIssuer.Issue(ctx, user)
+}
@@ -0,0 +1,84 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/EduardoMG12/cine/api_v2/internal/domain"
+	"github.com/EduardoMG12/cine/api_v2/internal/dto"
+	"github.com/EduardoMG12/cine/api_v2/internal/infrastructure"
+)
+
+type ResetPasswordUseCase struct {
+	userRepo        domain.UserRepository
+	resetTokenRepo  domain.PasswordResetTokenRepository
+	sessionRepo     domain.SessionRepository
+	sessionCache    *infrastructure.SessionCache
+	passwordService *infrastructure.PasswordService
+}
+
+func NewResetPasswordUseCase(
+	userRepo domain.UserRepository,
+	resetTokenRepo domain.PasswordResetTokenRepository,
+	sessionRepo domain.SessionRepository,
+	sessionCache *infrastructure.SessionCache,
+	passwordService *infrastructure.PasswordService,
+) *ResetPasswordUseCase {
+	return &ResetPasswordUseCase{
+		userRepo:        userRepo,
+		resetTokenRepo:  resetTokenRepo,
+		sessionRepo:     sessionRepo,
+		sessionCache:    sessionCache,
+		passwordService: passwordService,
+	}
+}
+
+func (uc *ResetPasswordUseCase) Execute(ctx context.Context, input dto.ResetPasswordRequestDTO) error {
+	if err := uc.passwordService.ValidateStrength(input.NewPassword); err != nil {
+		return err
+	}
+
+	resetToken, err := uc.resetTokenRepo.GetPasswordResetTokenByHash(ctx, infrastructure.HashToken(input.Token))
+	if err != nil {
+		return fmt.Errorf("invalid reset token")
+	}
+
+	if resetToken.UsedAt != nil || time.Now().After(resetToken.ExpiresAt) {
+		return fmt.Errorf("invalid reset token")
+	}
+
+	marked, err := uc.resetTokenRepo.MarkPasswordResetTokenUsed(ctx, resetToken.ID)
+	if err != nil {
+		return fmt.Errorf("failed to use reset token: %w", err)
+	}
+	if !marked {
+		return fmt.Errorf("invalid reset token")
+	}
+
+	hashedPassword, err := uc.passwordService.HashPassword(input.NewPassword)
+	if err != nil {
+		return fmt.Errorf("failed to hash password: %w", err)
+	}
+
+	if err := uc.userRepo.UpdatePassword(ctx, resetToken.UserID, hashedPassword); err != nil {
+		return fmt.Errorf("failed to update password: %w", err)
+	}
+
+	if err := uc.resetTokenRepo.DeleteUserPasswordResetTokens(ctx, resetToken.UserID); err != nil {
+		return fmt.Errorf("failed to clear reset tokens: %w", err)
+	}
+
+	// Whoever knew the old password must not stay logged in
+	sessions, err := uc.sessionRepo.GetUserSessions(ctx, resetToken.UserID)
+	if err != nil {
+		return fmt.Errorf("failed to revoke sessions: %w", err)
+	}
+
+	if err := uc.sessionRepo.DeleteUserSessions(ctx, resetToken.UserID); err != nil {
+		return fmt.Errorf("failed to revoke sessions: %w", err)
+	}
+
+	uc.sessionCache.RevokeSessions(ctx, sessions)
+	return nil
+}
@@ -0,0 +1,31 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/EduardoMG12/cine/api_v2/internal/domain"
+	"github.com/google/uuid"
+)
+
+type RevokePersonalAccessTokenUseCase struct {
+	tokenRepo domain.PersonalAccessTokenRepository
+}
+
+func NewRevokePersonalAccessTokenUseCase(tokenRepo domain.PersonalAccessTokenRepository) *RevokePersonalAccessTokenUseCase {
+	return &RevokePersonalAccessTokenUseCase{
+		tokenRepo: tokenRepo,
+	}
+}
+
+func (uc *RevokePersonalAccessTokenUseCase) Execute(ctx context.Context, userID, tokenID uuid.UUID) error {
+	deleted, err := uc.tokenRepo.DeletePersonalAccessToken(ctx, tokenID, userID)
+	if err != nil {
+		return err
+	}
+	if !deleted {
+		return fmt.Errorf("personal access token not found")
+	}
+
+	return nil
+}
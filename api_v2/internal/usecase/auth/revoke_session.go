@@ -0,0 +1,36 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/EduardoMG12/cine/api_v2/internal/domain"
+	"github.com/EduardoMG12/cine/api_v2/internal/infrastructure"
+	"github.com/google/uuid"
+)
+
+type RevokeSessionUseCase struct {
+	sessionRepo  domain.SessionRepository
+	sessionCache *infrastructure.SessionCache
+}
+
+func NewRevokeSessionUseCase(sessionRepo domain.SessionRepository, sessionCache *infrastructure.SessionCache) *RevokeSessionUseCase {
+	return &RevokeSessionUseCase{
+		sessionRepo:  sessionRepo,
+		sessionCache: sessionCache,
+	}
+}
+
+func (uc *RevokeSessionUseCase) Execute(ctx context.Context, userID, sessionID uuid.UUID) error {
+	session, err := uc.sessionRepo.GetSessionByID(ctx, sessionID)
+	if err != nil || session.UserID != userID {
+		return fmt.Errorf("session not found")
+	}
+
+	if err := uc.sessionRepo.DeleteSessionByID(ctx, session.ID); err != nil {
+		return fmt.Errorf("failed to revoke session: %w", err)
+	}
+
+	uc.sessionCache.Revoke(ctx, session.Token, session.ExpiresAt)
+	return nil
+}
@@ -0,0 +1,131 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/EduardoMG12/cine/api_v2/internal/domain"
+	"github.com/EduardoMG12/cine/api_v2/internal/infrastructure"
+)
+
+const refreshTokenBytes = 32
+
+// IssuedSession holds the credentials handed to the client for a new or refreshed session
+type IssuedSession struct {
+	Session      *domain.UserSession
+	AccessToken  string
+	RefreshToken string
+	ExpiresIn    int64 // access token lifetime in seconds
+}
+
+// SessionMetadata describes the device a session was started from
+type SessionMetadata struct {
+	UserAgent string
+	IPAddress string
+}
+
+// SessionIssuer creates sessions with a short-lived access token and a rotating refresh token
+type SessionIssuer struct {
+	sessionRepo      domain.SessionRepository
+	refreshTokenRepo domain.RefreshTokenRepository
+	jwtService       *infrastructure.JWTService
+	refreshTTL       time.Duration
+}
+
+func NewSessionIssuer(
+	sessionRepo domain.SessionRepository,
+	refreshTokenRepo domain.RefreshTokenRepository,
+	jwtService *infrastructure.JWTService,
+	refreshTTL time.Duration,
+) *SessionIssuer {
+	return &SessionIssuer{
+		sessionRepo:      sessionRepo,
+		refreshTokenRepo: refreshTokenRepo,
+		jwtService:       jwtService,
+		refreshTTL:       refreshTTL,
+	}
+}
+
+// Issue starts a new session for the user
+func (i *SessionIssuer) Issue(ctx context.Context, user *domain.User, metadata SessionMetadata) (*IssuedSession, error) {
+	token, err := i.jwtService.GenerateToken(user)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate token: %w", err)
+	}
+
+	session := &domain.UserSession{
+		UserID:    user.ID,
+		Token:     token,
+		ExpiresAt: time.Now().Add(i.refreshTTL),
+	}
+	if metadata.UserAgent != "" {
+		session.UserAgent = &metadata.UserAgent
+	}
+	if metadata.IPAddress != "" {
+		session.IPAddress = &metadata.IPAddress
+	}
+
+	if err := i.sessionRepo.CreateSession(ctx, session); err != nil {
+		return nil, fmt.Errorf("failed to create session: %w", err)
+	}
+
+	refreshToken, err := i.issueRefreshToken(ctx, session)
+	if err != nil {
+		return nil, err
+	}
+
+	return &IssuedSession{
+		Session:      session,
+		AccessToken:  token,
+		RefreshToken: refreshToken,
+		ExpiresIn:    int64(i.jwtService.ExpirationTime().Seconds()),
+	}, nil
+}
+
+// Rotate replaces the access token of an existing session and hands out the next refresh token of its family
+func (i *SessionIssuer) Rotate(ctx context.Context, session *domain.UserSession, user *domain.User) (*IssuedSession, error) {
+	token, err := i.jwtService.GenerateToken(user)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate token: %w", err)
+	}
+
+	session.Token = token
+	session.ExpiresAt = time.Now().Add(i.refreshTTL)
+
+	if err := i.sessionRepo.UpdateSession(ctx, session); err != nil {
+		return nil, fmt.Errorf("failed to update session: %w", err)
+	}
+
+	refreshToken, err := i.issueRefreshToken(ctx, session)
+	if err != nil {
+		return nil, err
+	}
+
+	return &IssuedSession{
+		Session:      session,
+		AccessToken:  token,
+		RefreshToken: refreshToken,
+		ExpiresIn:    int64(i.jwtService.ExpirationTime().Seconds()),
+	}, nil
+}
+
+func (i *SessionIssuer) issueRefreshToken(ctx context.Context, session *domain.UserSession) (string, error) {
+	refreshToken, err := infrastructure.GenerateOpaqueToken(refreshTokenBytes)
+	if err != nil {
+		return "", err
+	}
+
+	record := &domain.RefreshToken{
+		SessionID: session.ID,
+		UserID:    session.UserID,
+		TokenHash: infrastructure.HashToken(refreshToken),
+		ExpiresAt: session.ExpiresAt,
+	}
+
+	if err := i.refreshTokenRepo.CreateRefreshToken(ctx, record); err != nil {
+		return "", fmt.Errorf("failed to create refresh token: %w", err)
+	}
+
+	return refreshToken, nil
+}
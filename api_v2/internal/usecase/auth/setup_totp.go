@@ -0,0 +1,62 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/EduardoMG12/cine/api_v2/internal/domain"
+	"github.com/EduardoMG12/cine/api_v2/internal/dto"
+	"github.com/EduardoMG12/cine/api_v2/internal/infrastructure"
+	"github.com/google/uuid"
+)
+
+type SetupTOTPUseCase struct {
+	userRepo    domain.UserRepository
+	totpRepo    domain.TOTPRepository
+	totpService *infrastructure.TOTPService
+	mfaVerifier *MFAVerifier
+}
+
+func NewSetupTOTPUseCase(
+	userRepo domain.UserRepository,
+	totpRepo domain.TOTPRepository,
+	totpService *infrastructure.TOTPService,
+	mfaVerifier *MFAVerifier,
+) *SetupTOTPUseCase {
+	return &SetupTOTPUseCase{
+		userRepo:    userRepo,
+		totpRepo:    totpRepo,
+		totpService: totpService,
+		mfaVerifier: mfaVerifier,
+	}
+}
+
+// Execute starts a TOTP enrollment. 2FA stays disabled until a code is confirmed.
+func (uc *SetupTOTPUseCase) Execute(ctx context.Context, userID uuid.UUID) (*dto.TOTPSetupDTO, error) {
+	user, err := uc.userRepo.GetUserByID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("user not found")
+	}
+
+	enabled, err := uc.mfaVerifier.EnabledTOTP(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get two-factor settings: %w", err)
+	}
+	if enabled != nil {
+		return nil, fmt.Errorf("two-factor authentication already enabled")
+	}
+
+	secret, err := uc.totpService.GenerateSecret()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.totpRepo.SaveUserTOTP(ctx, &domain.UserTOTP{UserID: userID, Secret: secret}); err != nil {
+		return nil, err
+	}
+
+	return &dto.TOTPSetupDTO{
+		Secret:     secret,
+		OTPAuthURI: uc.totpService.ProvisioningURI(secret, user.Email),
+	}, nil
+}
@@ -0,0 +1,69 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/EduardoMG12/cine/api_v2/internal/domain"
+	"github.com/EduardoMG12/cine/api_v2/internal/infrastructure"
+)
+
+const emailVerificationTokenBytes = 32
+
+// VerificationIssuer creates email verification tokens and mails the confirmation link
+type VerificationIssuer struct {
+	tokenRepo domain.EmailVerificationTokenRepository
+	mailer    infrastructure.Mailer
+	appURL    string
+	tokenTTL  time.Duration
+}
+
+func NewVerificationIssuer(
+	tokenRepo domain.EmailVerificationTokenRepository,
+	mailer infrastructure.Mailer,
+	appURL string,
+	tokenTTL time.Duration,
+) *VerificationIssuer {
+	return &VerificationIssuer{
+		tokenRepo: tokenRepo,
+		mailer:    mailer,
+		appURL:    appURL,
+		tokenTTL:  tokenTTL,
+	}
+}
+
+func (i *VerificationIssuer) Issue(ctx context.Context, user *domain.User) error {
+	token, err := infrastructure.GenerateOpaqueToken(emailVerificationTokenBytes)
+	if err != nil {
+		return err
+	}
+
+	verificationToken := &domain.EmailVerificationToken{
+		UserID:    user.ID,
+		TokenHash: infrastructure.HashToken(token),
+		ExpiresAt: time.Now().Add(i.tokenTTL),
+	}
+
+	if err := i.tokenRepo.CreateEmailVerificationToken(ctx, verificationToken); err != nil {
+		return fmt.Errorf("failed to create email verification token: %w", err)
+	}
+
+	link := fmt.Sprintf("%s/verify-email?token=%s", strings.TrimRight(i.appURL, "/"), url.QueryEscape(token))
+	message := infrastructure.EmailMessage{
+		To:      user.Email,
+		Subject: "Confirm your CineVerse email",
+		Body: fmt.Sprintf(
+			"Hi %s,\n\nWelcome to CineVerse! Please confirm your email address using the link below:\n\n%s\n\nThe link expires in %s.\n",
+			user.DisplayName, link, i.tokenTTL,
+		),
+	}
+
+	if err := i.mailer.Send(message); err != nil {
+		return fmt.Errorf("failed to send verification email: %w", err)
+	}
+
+	return nil
+}
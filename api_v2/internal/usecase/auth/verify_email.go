@@ -0,0 +1,56 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/EduardoMG12/cine/api_v2/internal/domain"
+	"github.com/EduardoMG12/cine/api_v2/internal/dto"
+	"github.com/EduardoMG12/cine/api_v2/internal/infrastructure"
+)
+
+type VerifyEmailUseCase struct {
+	userRepo  domain.UserRepository
+	tokenRepo domain.EmailVerificationTokenRepository
+}
+
+func NewVerifyEmailUseCase(
+	userRepo domain.UserRepository,
+	tokenRepo domain.EmailVerificationTokenRepository,
+) *VerifyEmailUseCase {
+	return &VerifyEmailUseCase{
+		userRepo:  userRepo,
+		tokenRepo: tokenRepo,
+	}
+}
+
+func (uc *VerifyEmailUseCase) Execute(ctx context.Context, input dto.VerifyEmailRequestDTO) error {
+	token, err := uc.tokenRepo.GetEmailVerificationTokenByHash(ctx, infrastructure.HashToken(input.Token))
+	if err != nil {
+		return fmt.Errorf("invalid verification token")
+	}
+
+	if time.Now().After(token.ExpiresAt) {
+		return fmt.Errorf("invalid verification token")
+	}
+
+	user, err := uc.userRepo.GetUserByID(ctx, token.UserID)
+	if err != nil {
+		return fmt.Errorf("invalid verification token")
+	}
+
+	if !user.EmailVerified {
+		user.EmailVerified = true
+		if err := uc.userRepo.UpdateUser(ctx, user); err != nil {
+			return fmt.Errorf("failed to verify email: %w", err)
+		}
+	}
+
+	// Verification tokens are single use: drop every outstanding one
+	if err := uc.tokenRepo.DeleteUserEmailVerificationTokens(ctx, user.ID); err != nil {
+		return fmt.Errorf("failed to clear verification tokens: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,139 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/EduardoMG12/cine/api_v2/internal/domain"
+	"github.com/EduardoMG12/cine/api_v2/internal/dto"
+	"github.com/EduardoMG12/cine/api_v2/internal/infrastructure"
+)
+
+// Codes that can be tried per challenge before the user has to log in again
+const maxMFAChallengeAttempts = 5
+
+type VerifyMFALoginUseCase struct {
+	userRepo         domain.UserRepository
+	mfaChallengeRepo domain.MFAChallengeRepository
+	mfaVerifier      *MFAVerifier
+	sessionIssuer    *SessionIssuer
+	loginGuard       *LoginGuard
+}
+
+func NewVerifyMFALoginUseCase(
+	userRepo domain.UserRepository,
+	mfaChallengeRepo domain.MFAChallengeRepository,
+	mfaVerifier *MFAVerifier,
+	sessionIssuer *SessionIssuer,
+	loginGuard *LoginGuard,
+) *VerifyMFALoginUseCase {
+	return &VerifyMFALoginUseCase{
+		userRepo:         userRepo,
+		mfaChallengeRepo: mfaChallengeRepo,
+		mfaVerifier:      mfaVerifier,
+		sessionIssuer:    sessionIssuer,
+		loginGuard:       loginGuard,
+	}
+}
+
+// Execute completes the second login step and starts the session.
+// Wrong codes count as failed logins of the user, so the lockout also covers new challenges.
+func (uc *VerifyMFALoginUseCase) Execute(ctx context.Context, input dto.MFAVerifyRequestDTO, metadata SessionMetadata) (*dto.AuthResponseDTO, error) {
+	challenge, err := uc.mfaChallengeRepo.GetMFAChallengeByHash(ctx, infrastructure.HashToken(input.MFAToken))
+	if err != nil {
+		return nil, fmt.Errorf("invalid mfa token")
+	}
+
+	if time.Now().After(challenge.ExpiresAt) {
+		uc.mfaChallengeRepo.DeleteMFAChallenge(ctx, challenge.ID)
+		return nil, fmt.Errorf("invalid mfa token")
+	}
+
+	user, err := uc.userRepo.GetUserByID(ctx, challenge.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid mfa token")
+	}
+	email := strings.ToLower(user.Email)
+
+	if err := uc.loginGuard.Check(ctx, email, metadata); err != nil {
+		return nil, err
+	}
+
+	// The attempt is counted before the code is checked, so parallel requests share the limit
+	allowed, err := uc.mfaChallengeRepo.UseMFAChallengeAttempt(ctx, challenge.ID, maxMFAChallengeAttempts)
+	if err != nil {
+		return nil, err
+	}
+	if !allowed {
+		uc.mfaChallengeRepo.DeleteMFAChallenge(ctx, challenge.ID)
+		return nil, fmt.Errorf("invalid mfa token")
+	}
+
+	totp, err := uc.mfaVerifier.EnabledTOTP(ctx, challenge.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get two-factor settings: %w", err)
+	}
+	if totp == nil {
+		// 2FA was disabled after the challenge was issued
+		uc.mfaChallengeRepo.DeleteMFAChallenge(ctx, challenge.ID)
+		return nil, fmt.Errorf("invalid mfa token")
+	}
+
+	valid, err := uc.mfaVerifier.Verify(ctx, totp, input.Code)
+	if err != nil {
+		return nil, fmt.Errorf("failed to verify code: %w", err)
+	}
+	if !valid {
+		uc.loginGuard.RegisterFailure(ctx, email, metadata)
+		return nil, fmt.Errorf("invalid code")
+	}
+
+	if err := uc.mfaChallengeRepo.DeleteMFAChallenge(ctx, challenge.ID); err != nil {
+		return nil, err
+	}
+
+	uc.loginGuard.RegisterSuccess(ctx, email)
+
+	if user.Disabled() {
+		return nil, fmt.Errorf("account disabled")
+	}
+
+	// Logging in during the deletion grace period keeps the account
+	if user.DeletionScheduledAt != nil {
+		if err := uc.userRepo.CancelUserDeletion(ctx, user.ID); err != nil {
+			return nil, err
+		}
+		user.DeletionScheduledAt = nil
+	}
+
+	issued, err := uc.sessionIssuer.Issue(ctx, user, metadata)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.AuthResponseDTO{
+		Token:        issued.AccessToken,
+		RefreshToken: issued.RefreshToken,
+		ExpiresIn:    issued.ExpiresIn,
+		User:         uc.userToDTO(user),
+	}, nil
+}
+
+func (uc *VerifyMFALoginUseCase) userToDTO(user *domain.User) dto.UserDTO {
+	return dto.UserDTO{
+		ID:                user.ID,
+		Username:          user.Username,
+		Email:             user.Email,
+		DisplayName:       user.DisplayName,
+		Bio:               user.Bio,
+		ProfilePictureURL: user.ProfilePictureURL,
+		IsPrivate:         user.IsPrivate,
+		EmailVerified:     user.EmailVerified,
+		Theme:             user.Theme,
+		Role:              user.Role,
+		CreatedAt:         user.CreatedAt,
+		UpdatedAt:         user.UpdatedAt,
+	}
+}
@@ -0,0 +1,99 @@
+package movie
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/EduardoMG12/cine/api_v2/internal/domain"
+	"github.com/EduardoMG12/cine/api_v2/internal/dto"
+	"github.com/google/uuid"
+)
+
+const (
+	defaultBrowsePageSize = 20
+	maxBrowsePageSize     = 100
+	// Each person adds a subquery to the browse query
+	maxBrowsePeople = 5
+)
+
+type BrowseMoviesUseCase struct {
+	movieRepo domain.MovieRepository
+}
+
+func NewBrowseMoviesUseCase(movieRepo domain.MovieRepository) *BrowseMoviesUseCase {
+	return &BrowseMoviesUseCase{
+		movieRepo: movieRepo,
+	}
+}
+
+// Execute lists the stored titles of a type and/or genre and/or with every given person, optionally
+// in a given role (e.g. movies directed by one person and starring another is two calls)
+func (uc *BrowseMoviesUseCase) Execute(ctx context.Context, titleType, genre string, personIDs []uuid.UUID, role string, page, pageSize int) (*dto.MovieBrowseResponse, error) {
+	if titleType != "" && titleType != domain.TitleTypeMovie && titleType != domain.TitleTypeSeries && titleType != domain.TitleTypeEpisode {
+		return nil, fmt.Errorf("invalid type")
+	}
+	if role != "" && !domain.IsValidCreditRole(role) {
+		return nil, fmt.Errorf("invalid role")
+	}
+	if role != "" && len(personIDs) == 0 {
+		return nil, fmt.Errorf("role requires a person")
+	}
+	if len(personIDs) > maxBrowsePeople {
+		return nil, fmt.Errorf("too many people")
+	}
+
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 {
+		pageSize = defaultBrowsePageSize
+	}
+	if pageSize > maxBrowsePageSize {
+		pageSize = maxBrowsePageSize
+	}
+
+	movies, total, err := uc.movieRepo.BrowseMovies(ctx, domain.MovieBrowseFilter{
+		TitleType: titleType,
+		Genre:     strings.TrimSpace(genre),
+		PersonIDs: personIDs,
+		Role:      role,
+		Limit:     pageSize,
+		Offset:    (page - 1) * pageSize,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := &dto.MovieBrowseResponse{
+		Movies:   make([]dto.MovieDTO, 0, len(movies)),
+		Total:    total,
+		Page:     page,
+		PageSize: pageSize,
+	}
+	for _, movie := range movies {
+		result.Movies = append(result.Movies, uc.movieToDTO(movie))
+	}
+
+	return result, nil
+}
+
+func (uc *BrowseMoviesUseCase) movieToDTO(movie *domain.Movie) dto.MovieDTO {
+	return dto.MovieDTO{
+		ID:            movie.ID,
+		ExternalAPIID: movie.ExternalAPIID,
+		Title:         movie.Title,
+		TitleType:     movie.TitleType,
+		Overview:      movie.Overview,
+		ReleaseDate:   movie.ReleaseDate,
+		PosterURL:     movie.PosterURL,
+		BackdropURL:   movie.BackdropURL,
+		Genres:        movie.Genres,
+		Runtime:       movie.Runtime,
+		VoteAverage:   movie.VoteAverage,
+		VoteCount:     movie.VoteCount,
+		Adult:         movie.Adult,
+		CreatedAt:     movie.CreatedAt,
+		UpdatedAt:     movie.UpdatedAt,
+	}
+}
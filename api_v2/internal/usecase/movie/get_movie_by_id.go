@@ -1,47 +1,121 @@
 package movie
 
 import (
+	"context"
 	"fmt"
+	"log"
 
 	"github.com/EduardoMG12/cine/api_v2/internal/domain"
 	"github.com/EduardoMG12/cine/api_v2/internal/dto"
 	"github.com/EduardoMG12/cine/api_v2/internal/infrastructure"
+	"github.com/google/uuid"
 )
 
 type GetMovieByIDUseCase struct {
 	movieFetcher infrastructure.MovieFetcher
+	movieRepo    domain.MovieRepository
 }
 
-func NewGetMovieByIDUseCase(movieFetcher infrastructure.MovieFetcher) *GetMovieByIDUseCase {
+func NewGetMovieByIDUseCase(movieFetcher infrastructure.MovieFetcher, movieRepo domain.MovieRepository) *GetMovieByIDUseCase {
 	return &GetMovieByIDUseCase{
 		movieFetcher: movieFetcher,
+		movieRepo:    movieRepo,
 	}
 }
 
-func (uc *GetMovieByIDUseCase) Execute(externalID string) (*dto.MovieDTO, error) {
-	movie, err := uc.movieFetcher.FetchByExternalID(externalID)
+func (uc *GetMovieByIDUseCase) Execute(ctx context.Context, externalID string) (*dto.MovieDetailDTO, error) {
+	movie, err := uc.movieFetcher.FetchByExternalID(ctx, externalID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get movie: %w", err)
 	}
 
+	// Movies answered by a provider carry their credits and ratings, stored ones are loaded here
+	if movie.ID != uuid.Nil && movie.Credits == nil {
+		credits, err := uc.movieRepo.GetMovieCredits(ctx, movie.ID)
+		if err != nil {
+			log.Printf("[Movie] Failed to load credits of %s: %v", movie.ExternalAPIID, err)
+		}
+		movie.Credits = credits
+	}
+	if movie.ID != uuid.Nil && movie.Ratings == nil {
+		ratings, err := uc.movieRepo.GetMovieRatings(ctx, movie.ID)
+		if err != nil {
+			log.Printf("[Movie] Failed to load ratings of %s: %v", movie.ExternalAPIID, err)
+		}
+		movie.Ratings = ratings
+	}
+
 	return uc.movieToDTO(movie), nil
 }
 
-func (uc *GetMovieByIDUseCase) movieToDTO(movie *domain.Movie) *dto.MovieDTO {
-	return &dto.MovieDTO{
-		ID:            movie.ID,
-		ExternalAPIID: movie.ExternalAPIID,
-		Title:         movie.Title,
-		Overview:      movie.Overview,
-		ReleaseDate:   movie.ReleaseDate,
-		PosterURL:     movie.PosterURL,
-		BackdropURL:   movie.BackdropURL,
-		Genres:        movie.Genres,
-		Runtime:       movie.Runtime,
-		VoteAverage:   movie.VoteAverage,
-		VoteCount:     movie.VoteCount,
-		Adult:         movie.Adult,
-		CreatedAt:     movie.CreatedAt,
-		UpdatedAt:     movie.UpdatedAt,
+func (uc *GetMovieByIDUseCase) movieToDTO(movie *domain.Movie) *dto.MovieDetailDTO {
+	detail := &dto.MovieDetailDTO{
+		MovieDTO: dto.MovieDTO{
+			ID:            movie.ID,
+			ExternalAPIID: movie.ExternalAPIID,
+			Title:         movie.Title,
+			TitleType:     movie.TitleType,
+			Overview:      movie.Overview,
+			ReleaseDate:   movie.ReleaseDate,
+			PosterURL:     movie.PosterURL,
+			BackdropURL:   movie.BackdropURL,
+			Genres:        movie.Genres,
+			Runtime:       movie.Runtime,
+			VoteAverage:   movie.VoteAverage,
+			VoteCount:     movie.VoteCount,
+			Adult:         movie.Adult,
+			CreatedAt:     movie.CreatedAt,
+			UpdatedAt:     movie.UpdatedAt,
+		},
+		TotalSeasons: movie.TotalSeasons,
+		Languages:    []string{},
+		Countries:    []string{},
+		BoxOffice:    movie.BoxOffice,
+		Directors:    []dto.MovieCreditDTO{},
+		Writers:      []dto.MovieCreditDTO{},
+		Cast:         []dto.MovieCreditDTO{},
+		Ratings:      []dto.MovieRatingDTO{},
+	}
+
+	if movie.Languages != nil {
+		detail.Languages = movie.Languages
+	}
+	if movie.Countries != nil {
+		detail.Countries = movie.Countries
+	}
+	if movie.Awards != nil {
+		detail.Awards = &dto.MovieAwardsDTO{
+			Summary:     *movie.Awards,
+			Wins:        movie.AwardWins,
+			Nominations: movie.AwardNominations,
+		}
+	}
+
+	for _, credit := range movie.Credits {
+		creditDTO := dto.MovieCreditDTO{
+			PersonID:      credit.PersonID,
+			Name:          credit.Name,
+			CharacterName: credit.CharacterName,
+			BillingOrder:  credit.BillingOrder,
+		}
+		switch credit.Role {
+		case domain.CreditRoleDirector:
+			detail.Directors = append(detail.Directors, creditDTO)
+		case domain.CreditRoleWriter:
+			detail.Writers = append(detail.Writers, creditDTO)
+		case domain.CreditRoleActor:
+			detail.Cast = append(detail.Cast, creditDTO)
+		}
 	}
+
+	for _, rating := range movie.Ratings {
+		detail.Ratings = append(detail.Ratings, dto.MovieRatingDTO{
+			Source: rating.Source,
+			Value:  rating.Value,
+			Score:  rating.Score,
+			Votes:  rating.Votes,
+		})
+	}
+
+	return detail
 }
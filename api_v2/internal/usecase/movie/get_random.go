@@ -1,6 +1,7 @@
 package movie
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/EduardoMG12/cine/api_v2/internal/domain"
@@ -17,8 +18,8 @@ func NewGetRandomMovieUseCase(movieRepo domain.MovieRepository) *GetRandomMovieU
 	}
 }
 
-func (uc *GetRandomMovieUseCase) Execute() (*dto.MovieDTO, error) {
-	movie, err := uc.movieRepo.GetRandomMovie()
+func (uc *GetRandomMovieUseCase) Execute(ctx context.Context) (*dto.MovieDTO, error) {
+	movie, err := uc.movieRepo.GetRandomMovie(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get random movie: %w", err)
 	}
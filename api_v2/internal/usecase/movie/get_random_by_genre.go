@@ -1,6 +1,7 @@
 package movie
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/EduardoMG12/cine/api_v2/internal/domain"
@@ -17,8 +18,8 @@ func NewGetRandomMovieByGenreUseCase(movieRepo domain.MovieRepository) *GetRando
 	}
 }
 
-func (uc *GetRandomMovieByGenreUseCase) Execute(genre string) (*dto.MovieDTO, error) {
-	movie, err := uc.movieRepo.GetRandomMovieByGenre(genre)
+func (uc *GetRandomMovieByGenreUseCase) Execute(ctx context.Context, genre string) (*dto.MovieDTO, error) {
+	movie, err := uc.movieRepo.GetRandomMovieByGenre(ctx, genre)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get random movie by genre: %w", err)
 	}
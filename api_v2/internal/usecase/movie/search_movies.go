@@ -1,6 +1,7 @@
 package movie
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/EduardoMG12/cine/api_v2/internal/domain"
@@ -18,12 +19,12 @@ func NewSearchMoviesUseCase(movieFetcher infrastructure.MovieFetcher) *SearchMov
 	}
 }
 
-func (uc *SearchMoviesUseCase) Execute(query string, page int) ([]*dto.MovieDTO, error) {
+func (uc *SearchMoviesUseCase) Execute(ctx context.Context, query string, page int) ([]*dto.MovieDTO, error) {
 	if query == "" {
 		return nil, fmt.Errorf("search query cannot be empty")
 	}
 
-	movies, err := uc.movieFetcher.Search(query, page)
+	movies, err := uc.movieFetcher.Search(ctx, query, page)
 	if err != nil {
 		return nil, fmt.Errorf("failed to search movies: %w", err)
 	}
@@ -41,6 +42,7 @@ func (uc *SearchMoviesUseCase) movieToDTO(movie *domain.Movie) *dto.MovieDTO {
 		ID:            movie.ID,
 		ExternalAPIID: movie.ExternalAPIID,
 		Title:         movie.Title,
+		TitleType:     movie.TitleType,
 		Overview:      movie.Overview,
 		ReleaseDate:   movie.ReleaseDate,
 		PosterURL:     movie.PosterURL,
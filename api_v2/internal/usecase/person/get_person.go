@@ -0,0 +1,61 @@
+package person
+
+import (
+	"context"
+
+	"github.com/EduardoMG12/cine/api_v2/internal/domain"
+	"github.com/EduardoMG12/cine/api_v2/internal/dto"
+	"github.com/google/uuid"
+)
+
+type GetPersonUseCase struct {
+	personRepo domain.PersonRepository
+}
+
+func NewGetPersonUseCase(personRepo domain.PersonRepository) *GetPersonUseCase {
+	return &GetPersonUseCase{
+		personRepo: personRepo,
+	}
+}
+
+// Execute returns the person with their filmography among the stored movies
+func (uc *GetPersonUseCase) Execute(ctx context.Context, personID uuid.UUID) (*dto.PersonDetailDTO, error) {
+	person, err := uc.personRepo.GetPersonByID(ctx, personID)
+	if err != nil {
+		return nil, err
+	}
+
+	credits, err := uc.personRepo.GetPersonFilmography(ctx, personID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &dto.PersonDetailDTO{
+		ID:          person.ID,
+		Name:        person.Name,
+		Roles:       []string{},
+		Filmography: make([]dto.FilmographyEntryDTO, 0, len(credits)),
+	}
+
+	roles := map[string]bool{}
+	for _, credit := range credits {
+		result.Filmography = append(result.Filmography, dto.FilmographyEntryDTO{
+			MovieID:       credit.MovieID,
+			ExternalAPIID: credit.ExternalAPIID,
+			Title:         credit.Title,
+			ReleaseDate:   credit.ReleaseDate,
+			PosterURL:     credit.PosterURL,
+			Role:          credit.Role,
+			CharacterName: credit.CharacterName,
+			BillingOrder:  credit.BillingOrder,
+		})
+		roles[credit.Role] = true
+	}
+	for _, role := range []string{domain.CreditRoleDirector, domain.CreditRoleWriter, domain.CreditRoleActor} {
+		if roles[role] {
+			result.Roles = append(result.Roles, role)
+		}
+	}
+
+	return result, nil
+}
@@ -0,0 +1,65 @@
+package person
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/EduardoMG12/cine/api_v2/internal/domain"
+	"github.com/EduardoMG12/cine/api_v2/internal/dto"
+)
+
+const (
+	minPeopleSearchQueryLength = 2
+	defaultPeopleSearchLimit   = 20
+	maxPeopleSearchLimit       = 50
+)
+
+type SearchPeopleUseCase struct {
+	personRepo domain.PersonRepository
+}
+
+func NewSearchPeopleUseCase(personRepo domain.PersonRepository) *SearchPeopleUseCase {
+	return &SearchPeopleUseCase{
+		personRepo: personRepo,
+	}
+}
+
+// Execute searches people by name, exact and prefix matches first, then similar names
+func (uc *SearchPeopleUseCase) Execute(ctx context.Context, query string, page, pageSize int) (*dto.PersonSearchResponse, error) {
+	query = strings.ToLower(strings.Join(strings.Fields(query), " "))
+	if len(query) < minPeopleSearchQueryLength {
+		return nil, fmt.Errorf("query too short")
+	}
+
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 {
+		pageSize = defaultPeopleSearchLimit
+	}
+	if pageSize > maxPeopleSearchLimit {
+		pageSize = maxPeopleSearchLimit
+	}
+
+	people, total, err := uc.personRepo.SearchPeople(ctx, query, pageSize, (page-1)*pageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &dto.PersonSearchResponse{
+		People:   make([]dto.PersonDTO, 0, len(people)),
+		Total:    total,
+		Page:     page,
+		PageSize: pageSize,
+	}
+	for _, person := range people {
+		result.People = append(result.People, dto.PersonDTO{
+			ID:         person.ID,
+			Name:       person.Name,
+			MovieCount: person.MovieCount,
+		})
+	}
+
+	return result, nil
+}
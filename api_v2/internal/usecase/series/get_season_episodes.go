@@ -0,0 +1,162 @@
+package series
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/EduardoMG12/cine/api_v2/internal/domain"
+	"github.com/EduardoMG12/cine/api_v2/internal/dto"
+	"github.com/EduardoMG12/cine/api_v2/internal/infrastructure"
+	"github.com/google/uuid"
+)
+
+// Episodes of running seasons change, so a synced season is refreshed after seasonCacheTTL
+const seasonCacheTTL = 48 * time.Hour
+
+type GetSeasonEpisodesUseCase struct {
+	movieFetcher  infrastructure.MovieFetcher
+	seriesRepo    domain.SeriesRepository
+	seasonFetcher *infrastructure.SeasonFetcher
+}
+
+func NewGetSeasonEpisodesUseCase(
+	movieFetcher infrastructure.MovieFetcher,
+	seriesRepo domain.SeriesRepository,
+	seasonFetcher *infrastructure.SeasonFetcher,
+) *GetSeasonEpisodesUseCase {
+	return &GetSeasonEpisodesUseCase{
+		movieFetcher:  movieFetcher,
+		seriesRepo:    seriesRepo,
+		seasonFetcher: seasonFetcher,
+	}
+}
+
+// Execute returns the episodes of a season. A stored season is served until it expires, then synced
+// again from OMDb; when OMDb fails the stored episodes are served even if expired. Seasons past the
+// series' total are rejected without asking OMDb.
+func (uc *GetSeasonEpisodesUseCase) Execute(ctx context.Context, externalID string, seasonNumber int) (*dto.SeasonEpisodesDTO, error) {
+	if seasonNumber < 1 {
+		return nil, fmt.Errorf("invalid season")
+	}
+
+	series, err := fetchSeries(ctx, uc.movieFetcher, externalID)
+	if err != nil {
+		return nil, err
+	}
+	// Only seasons the series is known to have are looked up
+	if series.TotalSeasons == nil || seasonNumber > *series.TotalSeasons {
+		return nil, fmt.Errorf("season not found")
+	}
+
+	var stored *domain.Season
+	if series.ID != uuid.Nil {
+		stored, err = uc.seriesRepo.GetSeason(ctx, series.ID, seasonNumber)
+		if err != nil && err.Error() != "season not found" {
+			return nil, fmt.Errorf("failed to get season: %w", err)
+		}
+	}
+
+	if stored != nil && time.Now().Before(stored.CacheExpiresAt) {
+		return uc.storedSeason(ctx, series, seasonNumber)
+	}
+
+	episodes, err := uc.syncSeason(ctx, series, seasonNumber)
+	if err != nil {
+		if stored != nil {
+			log.Printf("[Series] Failed to sync season %d of %s, serving stored episodes: %v", seasonNumber, externalID, err)
+			return uc.storedSeason(ctx, series, seasonNumber)
+		}
+		if errors.Is(err, infrastructure.ErrProviderUnavailable) {
+			return nil, fmt.Errorf("episodes unavailable: %w", err)
+		}
+		return nil, fmt.Errorf("season not found")
+	}
+
+	return seasonToDTO(series, seasonNumber, episodes), nil
+}
+
+func (uc *GetSeasonEpisodesUseCase) storedSeason(ctx context.Context, series *domain.Movie, seasonNumber int) (*dto.SeasonEpisodesDTO, error) {
+	episodes, err := uc.seriesRepo.GetSeasonEpisodes(ctx, series.ID, seasonNumber)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get episodes: %w", err)
+	}
+	return seasonToDTO(series, seasonNumber, episodes), nil
+}
+
+// syncSeason fetches the season from OMDb and stores it when the series is stored
+func (uc *GetSeasonEpisodesUseCase) syncSeason(ctx context.Context, series *domain.Movie, seasonNumber int) ([]domain.Episode, error) {
+	details, err := uc.seasonFetcher.GetSeason(ctx, series.ExternalAPIID, seasonNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	episodes := make([]domain.Episode, 0, len(details.Episodes))
+	for _, item := range details.Episodes {
+		if item.Episode < 1 {
+			continue
+		}
+		episode := domain.Episode{
+			SeriesID:      series.ID,
+			SeasonNumber:  seasonNumber,
+			EpisodeNumber: item.Episode,
+			Title:         item.Title,
+		}
+		if item.IMDbID != "" {
+			imdbID := item.IMDbID
+			episode.ExternalAPIID = &imdbID
+		}
+		if released, err := time.Parse("2006-01-02", item.Released); err == nil {
+			episode.ReleaseDate = &released
+		}
+		if rating, err := strconv.ParseFloat(strings.TrimSpace(item.IMDbRating), 64); err == nil {
+			episode.IMDbRating = &rating
+		}
+		episodes = append(episodes, episode)
+	}
+
+	if series.ID == uuid.Nil {
+		return episodes, nil
+	}
+
+	now := time.Now()
+	season := &domain.Season{
+		SeriesID:       series.ID,
+		SeasonNumber:   seasonNumber,
+		EpisodeCount:   len(episodes),
+		LastSyncAt:     &now,
+		CacheExpiresAt: now.Add(seasonCacheTTL),
+	}
+	if err := uc.seriesRepo.SaveSeason(ctx, season, episodes); err != nil {
+		log.Printf("[Series] Failed to save season %d of %s: %v", seasonNumber, series.ExternalAPIID, err)
+	}
+
+	return episodes, nil
+}
+
+func seasonToDTO(series *domain.Movie, seasonNumber int, episodes []domain.Episode) *dto.SeasonEpisodesDTO {
+	result := &dto.SeasonEpisodesDTO{
+		SeriesID:      series.ID,
+		ExternalAPIID: series.ExternalAPIID,
+		SeriesTitle:   series.Title,
+		SeasonNumber:  seasonNumber,
+		TotalSeasons:  series.TotalSeasons,
+		Episodes:      make([]dto.EpisodeDTO, 0, len(episodes)),
+	}
+	for _, episode := range episodes {
+		result.Episodes = append(result.Episodes, dto.EpisodeDTO{
+			ID:            episode.ID,
+			SeasonNumber:  episode.SeasonNumber,
+			EpisodeNumber: episode.EpisodeNumber,
+			ExternalAPIID: episode.ExternalAPIID,
+			Title:         episode.Title,
+			ReleaseDate:   episode.ReleaseDate,
+			IMDbRating:    episode.IMDbRating,
+		})
+	}
+	return result
+}
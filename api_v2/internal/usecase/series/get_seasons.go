@@ -0,0 +1,85 @@
+package series
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/EduardoMG12/cine/api_v2/internal/domain"
+	"github.com/EduardoMG12/cine/api_v2/internal/dto"
+	"github.com/EduardoMG12/cine/api_v2/internal/infrastructure"
+	"github.com/google/uuid"
+)
+
+type GetSeasonsUseCase struct {
+	movieFetcher infrastructure.MovieFetcher
+	seriesRepo   domain.SeriesRepository
+}
+
+func NewGetSeasonsUseCase(movieFetcher infrastructure.MovieFetcher, seriesRepo domain.SeriesRepository) *GetSeasonsUseCase {
+	return &GetSeasonsUseCase{
+		movieFetcher: movieFetcher,
+		seriesRepo:   seriesRepo,
+	}
+}
+
+// Execute lists the seasons of a series by its external ID. Seasons are known from the series'
+// total; episode counts only for the seasons already synced.
+func (uc *GetSeasonsUseCase) Execute(ctx context.Context, externalID string) (*dto.SeriesSeasonsDTO, error) {
+	series, err := fetchSeries(ctx, uc.movieFetcher, externalID)
+	if err != nil {
+		return nil, err
+	}
+
+	stored := []domain.Season{}
+	if series.ID != uuid.Nil {
+		stored, err = uc.seriesRepo.GetSeasons(ctx, series.ID)
+		if err != nil {
+			return nil, err
+		}
+	}
+	synced := map[int]domain.Season{}
+	for _, season := range stored {
+		synced[season.SeasonNumber] = season
+	}
+
+	total := 0
+	if series.TotalSeasons != nil {
+		total = *series.TotalSeasons
+	}
+	for number := range synced {
+		if number > total {
+			total = number
+		}
+	}
+
+	result := &dto.SeriesSeasonsDTO{
+		SeriesID:      series.ID,
+		ExternalAPIID: series.ExternalAPIID,
+		Title:         series.Title,
+		TotalSeasons:  series.TotalSeasons,
+		Seasons:       make([]dto.SeasonSummaryDTO, 0, total),
+	}
+	for number := 1; number <= total; number++ {
+		summary := dto.SeasonSummaryDTO{SeasonNumber: number}
+		if season, ok := synced[number]; ok {
+			episodeCount := season.EpisodeCount
+			summary.EpisodeCount = &episodeCount
+			summary.LastSyncAt = season.LastSyncAt
+		}
+		result.Seasons = append(result.Seasons, summary)
+	}
+
+	return result, nil
+}
+
+// fetchSeries gets the title through the movie chain, which stores it, and checks it is a series
+func fetchSeries(ctx context.Context, movieFetcher infrastructure.MovieFetcher, externalID string) (*domain.Movie, error) {
+	series, err := movieFetcher.FetchByExternalID(ctx, externalID)
+	if err != nil {
+		return nil, fmt.Errorf("series not found")
+	}
+	if series.TitleType != domain.TitleTypeSeries {
+		return nil, fmt.Errorf("not a series")
+	}
+	return series, nil
+}
@@ -0,0 +1,66 @@
+package user
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/EduardoMG12/cine/api_v2/internal/domain"
+	"github.com/EduardoMG12/cine/api_v2/internal/dto"
+	"github.com/EduardoMG12/cine/api_v2/internal/infrastructure"
+	"github.com/google/uuid"
+)
+
+type ChangePasswordUseCase struct {
+	userRepo        domain.UserRepository
+	sessionRepo     domain.SessionRepository
+	sessionCache    *infrastructure.SessionCache
+	passwordService *infrastructure.PasswordService
+}
+
+func NewChangePasswordUseCase(
+	userRepo domain.UserRepository,
+	sessionRepo domain.SessionRepository,
+	sessionCache *infrastructure.SessionCache,
+	passwordService *infrastructure.PasswordService,
+) *ChangePasswordUseCase {
+	return &ChangePasswordUseCase{
+		userRepo:        userRepo,
+		sessionRepo:     sessionRepo,
+		sessionCache:    sessionCache,
+		passwordService: passwordService,
+	}
+}
+
+func (uc *ChangePasswordUseCase) Execute(ctx context.Context, userID, currentSessionID uuid.UUID, req *dto.ChangePasswordRequest) error {
+	user, err := uc.userRepo.GetUserByID(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("failed to get user: %w", err)
+	}
+
+	if !uc.passwordService.ComparePassword(user.PasswordHash, req.CurrentPassword) {
+		return fmt.Errorf("invalid current password")
+	}
+
+	if err := uc.passwordService.ValidateStrength(req.NewPassword); err != nil {
+		return err
+	}
+
+	if req.NewPassword == req.CurrentPassword {
+		return fmt.Errorf("password unchanged")
+	}
+
+	hashedPassword, err := uc.passwordService.HashPassword(req.NewPassword)
+	if err != nil {
+		return fmt.Errorf("failed to hash password: %w", err)
+	}
+
+	if err := uc.userRepo.UpdatePassword(ctx, userID, hashedPassword); err != nil {
+		return fmt.Errorf("failed to update password: %w", err)
+	}
+
+	if req.RevokeOtherSessions {
+		return revokeOtherSessions(ctx, uc.sessionRepo, uc.sessionCache, userID, currentSessionID)
+	}
+
+	return nil
+}
@@ -0,0 +1,60 @@
+package user
+
+import (
+	"context"
+
+	"github.com/EduardoMG12/cine/api_v2/internal/domain"
+	"github.com/EduardoMG12/cine/api_v2/internal/dto"
+	"github.com/google/uuid"
+)
+
+type CheckUsernameUseCase struct {
+	userRepo domain.UserRepository
+}
+
+func NewCheckUsernameUseCase(userRepo domain.UserRepository) *CheckUsernameUseCase {
+	return &CheckUsernameUseCase{
+		userRepo: userRepo,
+	}
+}
+
+// Execute tells whether a username can be registered, applying the same rules as registration
+func (uc *CheckUsernameUseCase) Execute(ctx context.Context, username string) (*dto.UsernameAvailabilityResponse, error) {
+	username = domain.NormalizeUsername(username)
+
+	reason, err := usernameUnavailableReason(ctx, uc.userRepo, username, uuid.Nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.UsernameAvailabilityResponse{
+		Username:  username,
+		Available: reason == "",
+		Reason:    reason,
+	}, nil
+}
+
+// usernameUnavailableReason returns why userID can't take a normalized username, or "" if it can.
+// Use uuid.Nil for someone who has no account yet.
+func usernameUnavailableReason(ctx context.Context, userRepo domain.UserRepository, username string, userID uuid.UUID) (string, error) {
+	if err := domain.ValidateUsername(username); err != nil {
+		if err.Error() == "username reserved" {
+			return "reserved", nil
+		}
+		return "invalid", nil
+	}
+
+	if existing, _ := userRepo.GetUserByUsername(ctx, username); existing != nil && existing.ID != userID {
+		return "taken", nil
+	}
+
+	reserved, err := userRepo.IsUsernameReserved(ctx, username, userID)
+	if err != nil {
+		return "", err
+	}
+	if reserved {
+		return "reserved", nil
+	}
+
+	return "", nil
+}
@@ -0,0 +1,85 @@
+package user
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/EduardoMG12/cine/api_v2/internal/domain"
+	"github.com/EduardoMG12/cine/api_v2/internal/dto"
+	"github.com/EduardoMG12/cine/api_v2/internal/infrastructure"
+	"github.com/google/uuid"
+)
+
+type ConfirmEmailChangeUseCase struct {
+	userRepo              domain.UserRepository
+	tokenRepo             domain.EmailChangeTokenRepository
+	verificationTokenRepo domain.EmailVerificationTokenRepository
+	sessionRepo           domain.SessionRepository
+	sessionCache          *infrastructure.SessionCache
+}
+
+func NewConfirmEmailChangeUseCase(
+	userRepo domain.UserRepository,
+	tokenRepo domain.EmailChangeTokenRepository,
+	verificationTokenRepo domain.EmailVerificationTokenRepository,
+	sessionRepo domain.SessionRepository,
+	sessionCache *infrastructure.SessionCache,
+) *ConfirmEmailChangeUseCase {
+	return &ConfirmEmailChangeUseCase{
+		userRepo:              userRepo,
+		tokenRepo:             tokenRepo,
+		verificationTokenRepo: verificationTokenRepo,
+		sessionRepo:           sessionRepo,
+		sessionCache:          sessionCache,
+	}
+}
+
+func (uc *ConfirmEmailChangeUseCase) Execute(ctx context.Context, req *dto.ConfirmEmailChangeRequest) error {
+	changeToken, err := uc.tokenRepo.GetEmailChangeTokenByHash(ctx, infrastructure.HashToken(req.Token))
+	if err != nil {
+		return fmt.Errorf("invalid email change token")
+	}
+
+	if time.Now().After(changeToken.ExpiresAt) {
+		return fmt.Errorf("invalid email change token")
+	}
+
+	// The address may have been taken since the change was requested
+	existingUser, _ := uc.userRepo.GetUserByEmail(ctx, changeToken.NewEmail)
+	if existingUser != nil {
+		return fmt.Errorf("email already registered")
+	}
+
+	user, err := uc.userRepo.GetUserByID(ctx, changeToken.UserID)
+	if err != nil {
+		return fmt.Errorf("invalid email change token")
+	}
+
+	user.Email = changeToken.NewEmail
+	// Following the link proves ownership of the new address
+	user.EmailVerified = true
+
+	if err := uc.userRepo.UpdateUser(ctx, user); err != nil {
+		return fmt.Errorf("failed to update user: %w", err)
+	}
+
+	if err := uc.tokenRepo.DeleteUserEmailChangeTokens(ctx, user.ID); err != nil {
+		return err
+	}
+
+	// Pending verification links were sent to the previous address
+	if err := uc.verificationTokenRepo.DeleteUserEmailVerificationTokens(ctx, user.ID); err != nil {
+		return err
+	}
+
+	if changeToken.RevokeOtherSessions {
+		keepSessionID := uuid.Nil
+		if changeToken.SessionID != nil {
+			keepSessionID = *changeToken.SessionID
+		}
+		return revokeOtherSessions(ctx, uc.sessionRepo, uc.sessionCache, user.ID, keepSessionID)
+	}
+
+	return nil
+}
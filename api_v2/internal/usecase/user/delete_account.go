@@ -0,0 +1,75 @@
+package user
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/EduardoMG12/cine/api_v2/internal/domain"
+	"github.com/EduardoMG12/cine/api_v2/internal/dto"
+	"github.com/EduardoMG12/cine/api_v2/internal/infrastructure"
+	"github.com/google/uuid"
+)
+
+type DeleteAccountUseCase struct {
+	userRepo        domain.UserRepository
+	sessionRepo     domain.SessionRepository
+	sessionCache    *infrastructure.SessionCache
+	tokenRepo       domain.PersonalAccessTokenRepository
+	passwordService *infrastructure.PasswordService
+	gracePeriod     time.Duration
+}
+
+func NewDeleteAccountUseCase(
+	userRepo domain.UserRepository,
+	sessionRepo domain.SessionRepository,
+	sessionCache *infrastructure.SessionCache,
+	tokenRepo domain.PersonalAccessTokenRepository,
+	passwordService *infrastructure.PasswordService,
+	gracePeriod time.Duration,
+) *DeleteAccountUseCase {
+	return &DeleteAccountUseCase{
+		userRepo:        userRepo,
+		sessionRepo:     sessionRepo,
+		sessionCache:    sessionCache,
+		tokenRepo:       tokenRepo,
+		passwordService: passwordService,
+		gracePeriod:     gracePeriod,
+	}
+}
+
+// Execute schedules the account for deletion, logs the user out everywhere and revokes its personal access tokens.
+// Logging in before the grace period ends cancels the deletion.
+func (uc *DeleteAccountUseCase) Execute(ctx context.Context, userID uuid.UUID, req *dto.DeleteAccountRequest) (*dto.AccountDeletionResponse, error) {
+	user, err := uc.userRepo.GetUserByID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+
+	if !uc.passwordService.ComparePassword(user.PasswordHash, req.Password) {
+		return nil, fmt.Errorf("invalid current password")
+	}
+
+	if err := revokeOtherSessions(ctx, uc.sessionRepo, uc.sessionCache, userID, uuid.Nil); err != nil {
+		return nil, err
+	}
+
+	if err := uc.tokenRepo.DeleteUserPersonalAccessTokens(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	deletionAt := time.Now().Add(uc.gracePeriod)
+
+	if uc.gracePeriod <= 0 {
+		if err := uc.userRepo.DeleteUser(ctx, userID); err != nil {
+			return nil, fmt.Errorf("failed to delete user: %w", err)
+		}
+		return &dto.AccountDeletionResponse{DeletionScheduledAt: deletionAt}, nil
+	}
+
+	if err := uc.userRepo.ScheduleUserDeletion(ctx, userID, deletionAt); err != nil {
+		return nil, err
+	}
+
+	return &dto.AccountDeletionResponse{DeletionScheduledAt: deletionAt}, nil
+}
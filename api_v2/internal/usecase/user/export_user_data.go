@@ -0,0 +1,34 @@
+package user
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/EduardoMG12/cine/api_v2/internal/domain"
+	"github.com/EduardoMG12/cine/api_v2/internal/dto"
+	"github.com/google/uuid"
+)
+
+type ExportUserDataUseCase struct {
+	exportRepo domain.UserDataExportRepository
+}
+
+func NewExportUserDataUseCase(exportRepo domain.UserDataExportRepository) *ExportUserDataUseCase {
+	return &ExportUserDataUseCase{
+		exportRepo: exportRepo,
+	}
+}
+
+func (uc *ExportUserDataUseCase) Execute(ctx context.Context, userID uuid.UUID) (*dto.UserDataExport, error) {
+	data, err := uc.exportRepo.ExportUserData(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to export user data: %w", err)
+	}
+
+	return &dto.UserDataExport{
+		UserID:     userID,
+		ExportedAt: time.Now().UTC(),
+		Data:       data,
+	}, nil
+}
@@ -0,0 +1,79 @@
+package user
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/EduardoMG12/cine/api_v2/internal/domain"
+	"github.com/EduardoMG12/cine/api_v2/internal/dto"
+	"github.com/google/uuid"
+)
+
+type GetProfileFavoriteMoviesUseCase struct {
+	userRepo     domain.UserRepository
+	favoriteRepo domain.FavoriteMovieRepository
+	movieRepo    domain.MovieRepository
+}
+
+func NewGetProfileFavoriteMoviesUseCase(
+	userRepo domain.UserRepository,
+	favoriteRepo domain.FavoriteMovieRepository,
+	movieRepo domain.MovieRepository,
+) *GetProfileFavoriteMoviesUseCase {
+	return &GetProfileFavoriteMoviesUseCase{
+		userRepo:     userRepo,
+		favoriteRepo: favoriteRepo,
+		movieRepo:    movieRepo,
+	}
+}
+
+// Execute returns the favorite movies of username if viewerID may see them
+func (uc *GetProfileFavoriteMoviesUseCase) Execute(ctx context.Context, username string, viewerID uuid.UUID) ([]dto.FavoriteMovieWithDetailsDTO, error) {
+	user, visible, err := resolveProfile(ctx, uc.userRepo, username, viewerID)
+	if err != nil {
+		return nil, err
+	}
+	if !visible {
+		return nil, fmt.Errorf("profile is private")
+	}
+
+	favoriteMovies, err := uc.favoriteRepo.GetUserFavoriteMovies(ctx, user.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get favorite movies: %w", err)
+	}
+
+	movieIDs := make([]uuid.UUID, 0, len(favoriteMovies))
+	for _, favorite := range favoriteMovies {
+		movieIDs = append(movieIDs, favorite.MovieID)
+	}
+
+	movies, err := uc.movieRepo.GetMoviesByIDs(ctx, movieIDs)
+	if err != nil {
+		return nil, err
+	}
+	moviesByID := make(map[uuid.UUID]*domain.Movie, len(movies))
+	for _, movie := range movies {
+		moviesByID[movie.ID] = movie
+	}
+
+	result := make([]dto.FavoriteMovieWithDetailsDTO, 0, len(favoriteMovies))
+	for _, favorite := range favoriteMovies {
+		movie, ok := moviesByID[favorite.MovieID]
+		if !ok {
+			continue
+		}
+
+		result = append(result, dto.FavoriteMovieWithDetailsDTO{
+			FavoriteMovie: dto.FavoriteMovieDTO{
+				ID:          favorite.ID,
+				UserID:      favorite.UserID,
+				MovieID:     favorite.MovieID,
+				FavoritedAt: favorite.FavoritedAt,
+				CreatedAt:   favorite.CreatedAt,
+			},
+			Movie: profileMovieToDTO(movie),
+		})
+	}
+
+	return result, nil
+}
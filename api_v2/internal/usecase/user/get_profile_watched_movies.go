@@ -0,0 +1,98 @@
+package user
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/EduardoMG12/cine/api_v2/internal/domain"
+	"github.com/EduardoMG12/cine/api_v2/internal/dto"
+	"github.com/google/uuid"
+)
+
+type GetProfileWatchedMoviesUseCase struct {
+	userRepo    domain.UserRepository
+	watchedRepo domain.WatchedMovieRepository
+	movieRepo   domain.MovieRepository
+}
+
+func NewGetProfileWatchedMoviesUseCase(
+	userRepo domain.UserRepository,
+	watchedRepo domain.WatchedMovieRepository,
+	movieRepo domain.MovieRepository,
+) *GetProfileWatchedMoviesUseCase {
+	return &GetProfileWatchedMoviesUseCase{
+		userRepo:    userRepo,
+		watchedRepo: watchedRepo,
+		movieRepo:   movieRepo,
+	}
+}
+
+// Execute returns the watched list of username if viewerID may see it
+func (uc *GetProfileWatchedMoviesUseCase) Execute(ctx context.Context, username string, viewerID uuid.UUID) ([]dto.WatchedMovieWithDetailsDTO, error) {
+	user, visible, err := resolveProfile(ctx, uc.userRepo, username, viewerID)
+	if err != nil {
+		return nil, err
+	}
+	if !visible {
+		return nil, fmt.Errorf("profile is private")
+	}
+
+	watchedMovies, err := uc.watchedRepo.GetUserWatchedMovies(ctx, user.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get watched movies: %w", err)
+	}
+
+	movieIDs := make([]uuid.UUID, 0, len(watchedMovies))
+	for _, watched := range watchedMovies {
+		movieIDs = append(movieIDs, watched.MovieID)
+	}
+
+	movies, err := uc.movieRepo.GetMoviesByIDs(ctx, movieIDs)
+	if err != nil {
+		return nil, err
+	}
+	moviesByID := make(map[uuid.UUID]*domain.Movie, len(movies))
+	for _, movie := range movies {
+		moviesByID[movie.ID] = movie
+	}
+
+	result := make([]dto.WatchedMovieWithDetailsDTO, 0, len(watchedMovies))
+	for _, watched := range watchedMovies {
+		movie, ok := moviesByID[watched.MovieID]
+		if !ok {
+			continue
+		}
+
+		result = append(result, dto.WatchedMovieWithDetailsDTO{
+			WatchedMovie: dto.WatchedMovieDTO{
+				ID:        watched.ID,
+				UserID:    watched.UserID,
+				MovieID:   watched.MovieID,
+				WatchedAt: watched.WatchedAt,
+				CreatedAt: watched.CreatedAt,
+			},
+			Movie: profileMovieToDTO(movie),
+		})
+	}
+
+	return result, nil
+}
+
+func profileMovieToDTO(movie *domain.Movie) dto.MovieDTO {
+	return dto.MovieDTO{
+		ID:            movie.ID,
+		ExternalAPIID: movie.ExternalAPIID,
+		Title:         movie.Title,
+		Overview:      movie.Overview,
+		ReleaseDate:   movie.ReleaseDate,
+		PosterURL:     movie.PosterURL,
+		BackdropURL:   movie.BackdropURL,
+		Genres:        movie.Genres,
+		Runtime:       movie.Runtime,
+		VoteAverage:   movie.VoteAverage,
+		VoteCount:     movie.VoteCount,
+		Adult:         movie.Adult,
+		CreatedAt:     movie.CreatedAt,
+		UpdatedAt:     movie.UpdatedAt,
+	}
+}
@@ -0,0 +1,80 @@
+package user
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/EduardoMG12/cine/api_v2/internal/domain"
+	"github.com/EduardoMG12/cine/api_v2/internal/dto"
+	"github.com/google/uuid"
+)
+
+type GetPublicProfileUseCase struct {
+	userRepo domain.UserRepository
+}
+
+func NewGetPublicProfileUseCase(userRepo domain.UserRepository) *GetPublicProfileUseCase {
+	return &GetPublicProfileUseCase{
+		userRepo: userRepo,
+	}
+}
+
+// Execute returns the profile of username as seen by viewerID (uuid.Nil for anonymous visitors)
+func (uc *GetPublicProfileUseCase) Execute(ctx context.Context, username string, viewerID uuid.UUID) (*dto.PublicProfile, error) {
+	user, visible, err := resolveProfile(ctx, uc.userRepo, username, viewerID)
+	if err != nil {
+		return nil, err
+	}
+
+	profile := &dto.PublicProfile{
+		ID:                user.ID,
+		Username:          user.Username,
+		DisplayName:       user.DisplayName,
+		ProfilePictureURL: user.ProfilePictureURL,
+		IsPrivate:         user.IsPrivate,
+		Restricted:        !visible,
+		CreatedAt:         user.CreatedAt,
+	}
+
+	if !visible {
+		return profile, nil
+	}
+
+	stats, err := uc.userRepo.GetUserProfileStats(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	profile.Bio = user.Bio
+	profile.ReviewCount = &stats.ReviewCount
+	profile.MovieListCount = &stats.MovieListCount
+	profile.FollowerCount = &stats.FollowerCount
+	profile.FollowingCount = &stats.FollowingCount
+
+	return profile, nil
+}
+
+// resolveProfile loads the owner of a profile and tells whether viewerID may see its content.
+// Private profiles are only visible to their owner and followers; disabled accounts and
+// accounts pending deletion are not found.
+func resolveProfile(ctx context.Context, userRepo domain.UserRepository, username string, viewerID uuid.UUID) (*domain.User, bool, error) {
+	user, err := userRepo.GetUserByUsername(ctx, domain.NormalizeUsername(username))
+	if err != nil || user.Disabled() || user.DeletionScheduledAt != nil {
+		return nil, false, fmt.Errorf("user not found")
+	}
+
+	if !user.IsPrivate || user.ID == viewerID {
+		return user, true, nil
+	}
+
+	if viewerID == uuid.Nil {
+		return user, false, nil
+	}
+
+	following, err := userRepo.IsFollowing(ctx, viewerID, user.ID)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return user, following, nil
+}
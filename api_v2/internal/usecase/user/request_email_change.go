@@ -0,0 +1,126 @@
+package user
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/mail"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/EduardoMG12/cine/api_v2/internal/domain"
+	"github.com/EduardoMG12/cine/api_v2/internal/dto"
+	"github.com/EduardoMG12/cine/api_v2/internal/infrastructure"
+	"github.com/google/uuid"
+)
+
+const emailChangeTokenBytes = 32
+
+type RequestEmailChangeUseCase struct {
+	userRepo        domain.UserRepository
+	tokenRepo       domain.EmailChangeTokenRepository
+	passwordService *infrastructure.PasswordService
+	mailer          infrastructure.Mailer
+	appURL          string
+	tokenTTL        time.Duration
+}
+
+func NewRequestEmailChangeUseCase(
+	userRepo domain.UserRepository,
+	tokenRepo domain.EmailChangeTokenRepository,
+	passwordService *infrastructure.PasswordService,
+	mailer infrastructure.Mailer,
+	appURL string,
+	tokenTTL time.Duration,
+) *RequestEmailChangeUseCase {
+	return &RequestEmailChangeUseCase{
+		userRepo:        userRepo,
+		tokenRepo:       tokenRepo,
+		passwordService: passwordService,
+		mailer:          mailer,
+		appURL:          appURL,
+		tokenTTL:        tokenTTL,
+	}
+}
+
+// Execute sends a confirmation link to the new address. The email only changes once it is confirmed.
+func (uc *RequestEmailChangeUseCase) Execute(ctx context.Context, userID, currentSessionID uuid.UUID, req *dto.ChangeEmailRequest) error {
+	user, err := uc.userRepo.GetUserByID(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("failed to get user: %w", err)
+	}
+
+	if !uc.passwordService.ComparePassword(user.PasswordHash, req.CurrentPassword) {
+		return fmt.Errorf("invalid current password")
+	}
+
+	newEmail := strings.ToLower(strings.TrimSpace(req.NewEmail))
+	if address, err := mail.ParseAddress(newEmail); err != nil || address.Address != newEmail {
+		return fmt.Errorf("invalid email")
+	}
+
+	if newEmail == user.Email {
+		return fmt.Errorf("email unchanged")
+	}
+
+	existingUser, _ := uc.userRepo.GetUserByEmail(ctx, newEmail)
+	if existingUser != nil {
+		return fmt.Errorf("email already registered")
+	}
+
+	// Only the latest request can be confirmed
+	if err := uc.tokenRepo.DeleteUserEmailChangeTokens(ctx, userID); err != nil {
+		return err
+	}
+
+	token, err := infrastructure.GenerateOpaqueToken(emailChangeTokenBytes)
+	if err != nil {
+		return err
+	}
+
+	changeToken := &domain.EmailChangeToken{
+		UserID:              userID,
+		NewEmail:            newEmail,
+		TokenHash:           infrastructure.HashToken(token),
+		RevokeOtherSessions: req.RevokeOtherSessions,
+		ExpiresAt:           time.Now().Add(uc.tokenTTL),
+	}
+	if currentSessionID != uuid.Nil {
+		changeToken.SessionID = &currentSessionID
+	}
+
+	if err := uc.tokenRepo.CreateEmailChangeToken(ctx, changeToken); err != nil {
+		return err
+	}
+
+	link := fmt.Sprintf("%s/confirm-email-change?token=%s", strings.TrimRight(uc.appURL, "/"), url.QueryEscape(token))
+	message := infrastructure.EmailMessage{
+		To:      newEmail,
+		Subject: "Confirm your new CineVerse email",
+		Body: fmt.Sprintf(
+			"Hi %s,\n\nPlease confirm that you want to use this address for your CineVerse account:\n\n%s\n\nThe link expires in %s.\n",
+			user.DisplayName, link, uc.tokenTTL,
+		),
+	}
+
+	if err := uc.mailer.Send(message); err != nil {
+		return fmt.Errorf("failed to send email change confirmation: %w", err)
+	}
+
+	// Let the owner of the current address know, in case the account was compromised
+	notice := infrastructure.EmailMessage{
+		To:      user.Email,
+		Subject: "Your CineVerse email is about to change",
+		Body: fmt.Sprintf(
+			"Hi %s,\n\nA request was made to change the email of your CineVerse account to %s.\nIf this wasn't you, change your password right away.\n",
+			user.DisplayName, newEmail,
+		),
+	}
+
+	if err := uc.mailer.Send(notice); err != nil {
+		log.Printf("[User] Failed to notify user %s about email change: %v", user.ID, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,68 @@
+package user
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/EduardoMG12/cine/api_v2/internal/domain"
+	"github.com/EduardoMG12/cine/api_v2/internal/dto"
+)
+
+const (
+	minUserSearchQueryLength = 2
+	defaultUserSearchLimit   = 20
+	maxUserSearchLimit       = 50
+)
+
+type SearchUsersUseCase struct {
+	userRepo domain.UserRepository
+}
+
+func NewSearchUsersUseCase(userRepo domain.UserRepository) *SearchUsersUseCase {
+	return &SearchUsersUseCase{
+		userRepo: userRepo,
+	}
+}
+
+// Execute searches users by username or display name.
+// Private accounts only show up when searched by their exact username.
+func (uc *SearchUsersUseCase) Execute(ctx context.Context, query string, page, pageSize int) (*dto.UserSearchResponse, error) {
+	query = strings.ToLower(strings.TrimSpace(query))
+	if len(query) < minUserSearchQueryLength {
+		return nil, fmt.Errorf("query too short")
+	}
+
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 {
+		pageSize = defaultUserSearchLimit
+	}
+	if pageSize > maxUserSearchLimit {
+		pageSize = maxUserSearchLimit
+	}
+
+	users, total, err := uc.userRepo.SearchUsers(ctx, query, pageSize, (page-1)*pageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &dto.UserSearchResponse{
+		Users:    make([]dto.UserSearchResult, 0, len(users)),
+		Total:    total,
+		Page:     page,
+		PageSize: pageSize,
+	}
+	for _, user := range users {
+		result.Users = append(result.Users, dto.UserSearchResult{
+			ID:                user.ID,
+			Username:          user.Username,
+			DisplayName:       user.DisplayName,
+			ProfilePictureURL: user.ProfilePictureURL,
+			IsPrivate:         user.IsPrivate,
+		})
+	}
+
+	return result, nil
+}
@@ -0,0 +1,36 @@
+package user
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/EduardoMG12/cine/api_v2/internal/domain"
+	"github.com/EduardoMG12/cine/api_v2/internal/infrastructure"
+	"github.com/google/uuid"
+)
+
+// revokeOtherSessions logs the user out of every session except keepSessionID.
+// With uuid.Nil every session is revoked.
+func revokeOtherSessions(ctx context.Context, sessionRepo domain.SessionRepository,
+	sessionCache *infrastructure.SessionCache,
+	userID, keepSessionID uuid.UUID,
+) error {
+	sessions, err := sessionRepo.GetUserSessions(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("failed to revoke sessions: %w", err)
+	}
+
+	if err := sessionRepo.DeleteUserSessionsExcept(ctx, userID, keepSessionID); err != nil {
+		return fmt.Errorf("failed to revoke sessions: %w", err)
+	}
+
+	revoked := make([]domain.UserSession, 0, len(sessions))
+	for _, session := range sessions {
+		if session.ID != keepSessionID {
+			revoked = append(revoked, session)
+		}
+	}
+
+	sessionCache.RevokeSessions(ctx, revoked)
+	return nil
+}
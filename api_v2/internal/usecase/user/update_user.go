@@ -1,6 +1,7 @@
 package user
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -10,21 +11,35 @@ import (
 )
 
 type UpdateUserUseCase struct {
-	userRepo domain.UserRepository
+	userRepo               domain.UserRepository
+	usernameChangeCooldown time.Duration
+	usernameReservation    time.Duration
 }
 
-func NewUpdateUserUseCase(userRepo domain.UserRepository) *UpdateUserUseCase {
+func NewUpdateUserUseCase(
+	userRepo domain.UserRepository,
+	usernameChangeCooldown time.Duration,
+	usernameReservation time.Duration,
+) *UpdateUserUseCase {
 	return &UpdateUserUseCase{
-		userRepo: userRepo,
+		userRepo:               userRepo,
+		usernameChangeCooldown: usernameChangeCooldown,
+		usernameReservation:    usernameReservation,
 	}
 }
 
-func (uc *UpdateUserUseCase) Execute(userID uuid.UUID, req *dto.UpdateUserRequest) (*dto.UserProfileResponse, error) {
-	user, err := uc.userRepo.GetUserByID(userID)
+func (uc *UpdateUserUseCase) Execute(ctx context.Context, userID uuid.UUID, req *dto.UpdateUserRequest) (*dto.UserProfileResponse, error) {
+	user, err := uc.userRepo.GetUserByID(ctx, userID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get user: %w", err)
 	}
 
+	if req.Username != nil {
+		if err := uc.changeUsername(ctx, user, domain.NormalizeUsername(*req.Username)); err != nil {
+			return nil, err
+		}
+	}
+
 	if req.DisplayName != nil {
 		user.DisplayName = *req.DisplayName
 	}
@@ -47,7 +62,7 @@ func (uc *UpdateUserUseCase) Execute(userID uuid.UUID, req *dto.UpdateUserReques
 
 	user.UpdatedAt = time.Now()
 
-	if err := uc.userRepo.UpdateUser(user); err != nil {
+	if err := uc.userRepo.UpdateUser(ctx, user); err != nil {
 		return nil, fmt.Errorf("failed to update user: %w", err)
 	}
 
@@ -61,7 +76,41 @@ func (uc *UpdateUserUseCase) Execute(userID uuid.UUID, req *dto.UpdateUserReques
 		IsPrivate:         user.IsPrivate,
 		EmailVerified:     user.EmailVerified,
 		Theme:             user.Theme,
+		UsernameChangedAt: user.UsernameChangedAt,
 		CreatedAt:         user.CreatedAt,
 		UpdatedAt:         user.UpdatedAt,
 	}, nil
 }
+
+// changeUsername renames the user, keeping the previous username reserved for them
+func (uc *UpdateUserUseCase) changeUsername(ctx context.Context, user *domain.User, username string) error {
+	if username == user.Username {
+		return nil
+	}
+
+	if user.UsernameChangedAt != nil && time.Since(*user.UsernameChangedAt) < uc.usernameChangeCooldown {
+		return fmt.Errorf("username change cooldown")
+	}
+
+	reason, err := usernameUnavailableReason(ctx, uc.userRepo, username, user.ID)
+	if err != nil {
+		return err
+	}
+	switch reason {
+	case "invalid":
+		return fmt.Errorf("invalid username")
+	case "reserved":
+		return fmt.Errorf("username reserved")
+	case "taken":
+		return fmt.Errorf("username already taken")
+	}
+
+	if err := uc.userRepo.ChangeUsername(ctx, user.ID, user.Username, username, time.Now().Add(uc.usernameReservation)); err != nil {
+		return err
+	}
+
+	now := time.Now()
+	user.Username = username
+	user.UsernameChangedAt = &now
+	return nil
+}
@@ -1,6 +1,7 @@
 package user_movie
 
 import (
+	"context"
 	"database/sql"
 	"errors"
 	"fmt"
@@ -25,8 +26,8 @@ func NewAddFavoriteMovieUseCase(
 	}
 }
 
-func (uc *AddFavoriteMovieUseCase) Execute(userID, movieID uuid.UUID) (*dto.FavoriteMovieDTO, error) {
-	_, err := uc.movieRepo.GetMovieByID(movieID)
+func (uc *AddFavoriteMovieUseCase) Execute(ctx context.Context, userID, movieID uuid.UUID) (*dto.FavoriteMovieDTO, error) {
+	_, err := uc.movieRepo.GetMovieByID(ctx, movieID)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, fmt.Errorf("movie not found")
@@ -34,7 +35,7 @@ func (uc *AddFavoriteMovieUseCase) Execute(userID, movieID uuid.UUID) (*dto.Favo
 		return nil, fmt.Errorf("failed to verify movie: %w", err)
 	}
 
-	favorite, err := uc.favoriteRepo.AddFavoriteMovie(userID, movieID)
+	favorite, err := uc.favoriteRepo.AddFavoriteMovie(ctx, userID, movieID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to add favorite movie: %w", err)
 	}
@@ -1,6 +1,7 @@
 package user_movie
 
 import (
+	"context"
 	"database/sql"
 	"errors"
 	"fmt"
@@ -25,8 +26,8 @@ func NewAddWatchedMovieUseCase(
 	}
 }
 
-func (uc *AddWatchedMovieUseCase) Execute(userID, movieID uuid.UUID) (*dto.WatchedMovieDTO, error) {
-	_, err := uc.movieRepo.GetMovieByID(movieID)
+func (uc *AddWatchedMovieUseCase) Execute(ctx context.Context, userID, movieID uuid.UUID) (*dto.WatchedMovieDTO, error) {
+	_, err := uc.movieRepo.GetMovieByID(ctx, movieID)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, fmt.Errorf("movie not found")
@@ -34,7 +35,7 @@ func (uc *AddWatchedMovieUseCase) Execute(userID, movieID uuid.UUID) (*dto.Watch
 		return nil, fmt.Errorf("failed to verify movie: %w", err)
 	}
 
-	watched, err := uc.watchedRepo.AddWatchedMovie(userID, movieID)
+	watched, err := uc.watchedRepo.AddWatchedMovie(ctx, userID, movieID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to add watched movie: %w", err)
 	}
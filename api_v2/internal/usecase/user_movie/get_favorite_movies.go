@@ -1,6 +1,7 @@
 package user_movie
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/EduardoMG12/cine/api_v2/internal/domain"
@@ -23,15 +24,15 @@ func NewGetFavoriteMoviesUseCase(
 	}
 }
 
-func (uc *GetFavoriteMoviesUseCase) Execute(userID uuid.UUID) ([]dto.FavoriteMovieWithDetailsDTO, error) {
-	favoriteMovies, err := uc.favoriteRepo.GetUserFavoriteMovies(userID)
+func (uc *GetFavoriteMoviesUseCase) Execute(ctx context.Context, userID uuid.UUID) ([]dto.FavoriteMovieWithDetailsDTO, error) {
+	favoriteMovies, err := uc.favoriteRepo.GetUserFavoriteMovies(ctx, userID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get favorite movies: %w", err)
 	}
 
 	var result []dto.FavoriteMovieWithDetailsDTO
 	for _, favorite := range favoriteMovies {
-		movie, err := uc.movieRepo.GetMovieByID(favorite.MovieID)
+		movie, err := uc.movieRepo.GetMovieByID(ctx, favorite.MovieID)
 		if err != nil {
 			continue
 		}
@@ -0,0 +1,97 @@
+package user_movie
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/EduardoMG12/cine/api_v2/internal/domain"
+	"github.com/EduardoMG12/cine/api_v2/internal/dto"
+	"github.com/google/uuid"
+)
+
+type GetSeriesProgressUseCase struct {
+	watchedEpisodeRepo domain.WatchedEpisodeRepository
+	seriesRepo         domain.SeriesRepository
+	movieRepo          domain.MovieRepository
+}
+
+func NewGetSeriesProgressUseCase(
+	watchedEpisodeRepo domain.WatchedEpisodeRepository,
+	seriesRepo domain.SeriesRepository,
+	movieRepo domain.MovieRepository,
+) *GetSeriesProgressUseCase {
+	return &GetSeriesProgressUseCase{
+		watchedEpisodeRepo: watchedEpisodeRepo,
+		seriesRepo:         seriesRepo,
+		movieRepo:          movieRepo,
+	}
+}
+
+// Execute counts the watched episodes of a series over its synced seasons. The next episode is the
+// first unwatched one after the last episode watched.
+func (uc *GetSeriesProgressUseCase) Execute(ctx context.Context, userID, seriesID uuid.UUID) (*dto.SeriesProgressDTO, error) {
+	series, err := uc.movieRepo.GetMovieByID(ctx, seriesID)
+	if err != nil {
+		return nil, fmt.Errorf("series not found")
+	}
+	if series.TitleType != domain.TitleTypeSeries {
+		return nil, fmt.Errorf("not a series")
+	}
+
+	episodes, err := uc.seriesRepo.GetSeriesEpisodes(ctx, seriesID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get episodes: %w", err)
+	}
+
+	watchedEpisodes, err := uc.watchedEpisodeRepo.GetWatchedEpisodesOfSeries(ctx, userID, seriesID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get watched episodes: %w", err)
+	}
+	watched := map[uuid.UUID]domain.WatchedEpisode{}
+	for _, watchedEpisode := range watchedEpisodes {
+		watched[watchedEpisode.EpisodeID] = watchedEpisode
+	}
+
+	result := &dto.SeriesProgressDTO{
+		SeriesID:      series.ID,
+		Title:         series.Title,
+		TotalEpisodes: len(episodes),
+		Watched:       []dto.WatchedEpisodeDTO{},
+	}
+
+	lastWatched := -1
+	for i, episode := range episodes {
+		watchedEpisode, ok := watched[episode.ID]
+		if !ok {
+			continue
+		}
+		lastWatched = i
+		result.Watched = append(result.Watched, dto.WatchedEpisodeDTO{
+			Episode:   episodeToDTO(episode),
+			WatchedAt: watchedEpisode.WatchedAt,
+		})
+	}
+	result.WatchedEpisodes = len(result.Watched)
+
+	for _, episode := range episodes[lastWatched+1:] {
+		if _, ok := watched[episode.ID]; !ok {
+			next := episodeToDTO(episode)
+			result.NextEpisode = &next
+			break
+		}
+	}
+
+	return result, nil
+}
+
+func episodeToDTO(episode domain.Episode) dto.EpisodeDTO {
+	return dto.EpisodeDTO{
+		ID:            episode.ID,
+		SeasonNumber:  episode.SeasonNumber,
+		EpisodeNumber: episode.EpisodeNumber,
+		ExternalAPIID: episode.ExternalAPIID,
+		Title:         episode.Title,
+		ReleaseDate:   episode.ReleaseDate,
+		IMDbRating:    episode.IMDbRating,
+	}
+}
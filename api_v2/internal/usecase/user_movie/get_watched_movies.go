@@ -1,6 +1,7 @@
 package user_movie
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/EduardoMG12/cine/api_v2/internal/domain"
@@ -23,15 +24,15 @@ func NewGetWatchedMoviesUseCase(
 	}
 }
 
-func (uc *GetWatchedMoviesUseCase) Execute(userID uuid.UUID) ([]dto.WatchedMovieWithDetailsDTO, error) {
-	watchedMovies, err := uc.watchedRepo.GetUserWatchedMovies(userID)
+func (uc *GetWatchedMoviesUseCase) Execute(ctx context.Context, userID uuid.UUID) ([]dto.WatchedMovieWithDetailsDTO, error) {
+	watchedMovies, err := uc.watchedRepo.GetUserWatchedMovies(ctx, userID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get watched movies: %w", err)
 	}
 
 	var result []dto.WatchedMovieWithDetailsDTO
 	for _, watched := range watchedMovies {
-		movie, err := uc.movieRepo.GetMovieByID(watched.MovieID)
+		movie, err := uc.movieRepo.GetMovieByID(ctx, watched.MovieID)
 		if err != nil {
 			continue
 		}
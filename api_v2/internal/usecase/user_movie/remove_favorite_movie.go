@@ -1,6 +1,7 @@
 package user_movie
 
 import (
+	"context"
 	"database/sql"
 	"errors"
 	"fmt"
@@ -21,8 +22,8 @@ func NewRemoveFavoriteMovieUseCase(
 	}
 }
 
-func (uc *RemoveFavoriteMovieUseCase) Execute(userID, movieID uuid.UUID) error {
-	err := uc.favoriteRepo.RemoveFavoriteMovie(userID, movieID)
+func (uc *RemoveFavoriteMovieUseCase) Execute(ctx context.Context, userID, movieID uuid.UUID) error {
+	err := uc.favoriteRepo.RemoveFavoriteMovie(ctx, userID, movieID)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return fmt.Errorf("movie not in favorites list")
@@ -1,6 +1,7 @@
 package user_movie
 
 import (
+	"context"
 	"database/sql"
 	"errors"
 	"fmt"
@@ -21,8 +22,8 @@ func NewRemoveWatchedMovieUseCase(
 	}
 }
 
-func (uc *RemoveWatchedMovieUseCase) Execute(userID, movieID uuid.UUID) error {
-	err := uc.watchedRepo.RemoveWatchedMovie(userID, movieID)
+func (uc *RemoveWatchedMovieUseCase) Execute(ctx context.Context, userID, movieID uuid.UUID) error {
+	err := uc.watchedRepo.RemoveWatchedMovie(ctx, userID, movieID)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return fmt.Errorf("movie not in watched list")
@@ -1,6 +1,7 @@
 package user_movie
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/EduardoMG12/cine/api_v2/internal/domain"
@@ -23,19 +24,19 @@ func NewToggleFavoriteMovieUseCase(
 	}
 }
 
-func (uc *ToggleFavoriteMovieUseCase) Execute(userID, movieID uuid.UUID) (*dto.ToggleResponse, error) {
-	_, err := uc.movieRepo.GetMovieByID(movieID)
+func (uc *ToggleFavoriteMovieUseCase) Execute(ctx context.Context, userID, movieID uuid.UUID) (*dto.ToggleResponse, error) {
+	_, err := uc.movieRepo.GetMovieByID(ctx, movieID)
 	if err != nil {
 		return nil, fmt.Errorf("movie not found")
 	}
 
-	isFavorite, err := uc.favoriteRepo.IsMovieFavorite(userID, movieID)
+	isFavorite, err := uc.favoriteRepo.IsMovieFavorite(ctx, userID, movieID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to check favorite status: %w", err)
 	}
 
 	if isFavorite {
-		err = uc.favoriteRepo.RemoveFavoriteMovie(userID, movieID)
+		err = uc.favoriteRepo.RemoveFavoriteMovie(ctx, userID, movieID)
 		if err != nil {
 			return nil, fmt.Errorf("failed to remove from favorites: %w", err)
 		}
@@ -45,7 +46,7 @@ func (uc *ToggleFavoriteMovieUseCase) Execute(userID, movieID uuid.UUID) (*dto.T
 		}, nil
 	}
 
-	_, err = uc.favoriteRepo.AddFavoriteMovie(userID, movieID)
+	_, err = uc.favoriteRepo.AddFavoriteMovie(ctx, userID, movieID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to add to favorites: %w", err)
 	}
@@ -0,0 +1,58 @@
+package user_movie
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/EduardoMG12/cine/api_v2/internal/domain"
+	"github.com/EduardoMG12/cine/api_v2/internal/dto"
+	"github.com/google/uuid"
+)
+
+type ToggleWatchedEpisodeUseCase struct {
+	watchedEpisodeRepo domain.WatchedEpisodeRepository
+	seriesRepo         domain.SeriesRepository
+}
+
+func NewToggleWatchedEpisodeUseCase(
+	watchedEpisodeRepo domain.WatchedEpisodeRepository,
+	seriesRepo domain.SeriesRepository,
+) *ToggleWatchedEpisodeUseCase {
+	return &ToggleWatchedEpisodeUseCase{
+		watchedEpisodeRepo: watchedEpisodeRepo,
+		seriesRepo:         seriesRepo,
+	}
+}
+
+func (uc *ToggleWatchedEpisodeUseCase) Execute(ctx context.Context, userID, episodeID uuid.UUID) (*dto.ToggleResponse, error) {
+	_, err := uc.seriesRepo.GetEpisodeByID(ctx, episodeID)
+	if err != nil {
+		return nil, fmt.Errorf("episode not found")
+	}
+
+	isWatched, err := uc.watchedEpisodeRepo.IsEpisodeWatched(ctx, userID, episodeID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check watched status: %w", err)
+	}
+
+	if isWatched {
+		err = uc.watchedEpisodeRepo.RemoveWatchedEpisode(ctx, userID, episodeID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to remove from watched episodes: %w", err)
+		}
+		return &dto.ToggleResponse{
+			Added:   false,
+			Message: "Episode removed from watched episodes",
+		}, nil
+	}
+
+	_, err = uc.watchedEpisodeRepo.AddWatchedEpisode(ctx, userID, episodeID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to add to watched episodes: %w", err)
+	}
+
+	return &dto.ToggleResponse{
+		Added:   true,
+		Message: "Episode added to watched episodes",
+	}, nil
+}
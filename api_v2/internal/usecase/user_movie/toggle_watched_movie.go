@@ -1,6 +1,7 @@
 package user_movie
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/EduardoMG12/cine/api_v2/internal/domain"
@@ -23,19 +24,19 @@ func NewToggleWatchedMovieUseCase(
 	}
 }
 
-func (uc *ToggleWatchedMovieUseCase) Execute(userID, movieID uuid.UUID) (*dto.ToggleResponse, error) {
-	_, err := uc.movieRepo.GetMovieByID(movieID)
+func (uc *ToggleWatchedMovieUseCase) Execute(ctx context.Context, userID, movieID uuid.UUID) (*dto.ToggleResponse, error) {
+	_, err := uc.movieRepo.GetMovieByID(ctx, movieID)
 	if err != nil {
 		return nil, fmt.Errorf("movie not found")
 	}
 
-	isWatched, err := uc.watchedRepo.IsMovieWatched(userID, movieID)
+	isWatched, err := uc.watchedRepo.IsMovieWatched(ctx, userID, movieID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to check watched status: %w", err)
 	}
 
 	if isWatched {
-		err = uc.watchedRepo.RemoveWatchedMovie(userID, movieID)
+		err = uc.watchedRepo.RemoveWatchedMovie(ctx, userID, movieID)
 		if err != nil {
 			return nil, fmt.Errorf("failed to remove from watched list: %w", err)
 		}
@@ -45,7 +46,7 @@ func (uc *ToggleWatchedMovieUseCase) Execute(userID, movieID uuid.UUID) (*dto.To
 		}, nil
 	}
 
-	_, err = uc.watchedRepo.AddWatchedMovie(userID, movieID)
+	_, err = uc.watchedRepo.AddWatchedMovie(ctx, userID, movieID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to add to watched list: %w", err)
 	}
@@ -0,0 +1,78 @@
+package worker
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/EduardoMG12/cine/api_v2/internal/domain"
+)
+
+// AccountPurgeWorker hard deletes accounts whose deletion grace period has ended.
+// Deleting the user row cascades to every user-owned table.
+type AccountPurgeWorker struct {
+	userRepo domain.UserRepository
+	interval time.Duration
+
+	cancel context.CancelFunc
+	stop   chan struct{}
+	done   chan struct{}
+}
+
+func NewAccountPurgeWorker(userRepo domain.UserRepository, interval time.Duration) *AccountPurgeWorker {
+	return &AccountPurgeWorker{
+		userRepo: userRepo,
+		interval: interval,
+	}
+}
+
+func (w *AccountPurgeWorker) Start() {
+	if w.stop != nil || w.interval <= 0 {
+		return
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	w.cancel = cancel
+	w.stop = make(chan struct{})
+	w.done = make(chan struct{})
+
+	go func() {
+		defer close(w.done)
+
+		ticker := time.NewTicker(w.interval)
+		defer ticker.Stop()
+
+		w.purge(ctx)
+		for {
+			select {
+			case <-ticker.C:
+				w.purge(ctx)
+			case <-w.stop:
+				return
+			}
+		}
+	}()
+}
+
+func (w *AccountPurgeWorker) Stop() {
+	if w.stop == nil {
+		return
+	}
+
+	w.cancel()
+	close(w.stop)
+	<-w.done
+	w.stop = nil
+}
+
+func (w *AccountPurgeWorker) purge(ctx context.Context) {
+	purged, err := w.userRepo.PurgeScheduledUsers(ctx, time.Now())
+	if err != nil {
+		log.Printf("[AccountPurge] Failed to purge accounts: %v", err)
+		return
+	}
+
+	if purged > 0 {
+		log.Printf("[AccountPurge] Deleted %d accounts scheduled for deletion", purged)
+	}
+}
@@ -0,0 +1,363 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/EduardoMG12/cine/api_v2/internal/domain"
+	"github.com/EduardoMG12/cine/api_v2/internal/infrastructure"
+	"github.com/google/uuid"
+)
+
+const (
+	catalogRefreshLockKey = "catalog:refresh:lock"
+	catalogRefreshLockTTL = 2 * time.Minute
+	// Budget use is counted per clock hour, in a key named after the hour
+	catalogRefreshBudgetKeyPrefix = "catalog:refresh:budget:"
+)
+
+var (
+	errNotRefreshed   = errors.New("no provider returned fresh data")
+	errQuotaExhausted = errors.New("OMDb quota exhausted, catalog refresh paused")
+)
+
+// CatalogRefreshStats describes the worker configuration, its budget use and the last run
+type CatalogRefreshStats struct {
+	Enabled          bool
+	Running          bool
+	Interval         time.Duration
+	HourlyBudget     int
+	UsedThisHour     int
+	RetryDelay       time.Duration
+	BackedOff        int
+	CurrentRunTotal  int // movies selected by the run in progress
+	CurrentRunDone   int
+	LastRunStarted   *time.Time
+	LastRunFinished  *time.Time
+	LastRunDuration  time.Duration
+	LastRunSelected  int
+	LastRunRefreshed int
+	LastRunFailed    int
+	LastError        string
+	TotalRefreshed   int
+	TotalFailed      int
+}
+
+// CatalogRefreshWorker re-syncs stored movies whose cache_expires_at has passed, most watched and
+// favorited first. Movies are fetched again through the provider part of the chain, whose auto-save
+// stores the new data and expiration. At most hourlyBudget movies are refreshed per hour, spread over
+// the runs of the hour; movies that fail are left alone for retryDelay. Runs are skipped while the
+// chain is in database-only mode because the OMDb quota is exhausted. Each run holds a distributed
+// lock and the budget use is counted in Redis, so the budget is shared by every instance.
+type CatalogRefreshWorker struct {
+	movieRepo    domain.MovieRepository
+	refresher    infrastructure.MovieFetcher
+	omdbQuota    *infrastructure.OMDbQuotaTracker
+	redis        *infrastructure.RedisService
+	lock         *infrastructure.DistributedLock
+	interval     time.Duration
+	hourlyBudget int
+	retryDelay   time.Duration
+
+	mu           sync.Mutex
+	running      bool
+	hourStart    time.Time
+	usedThisHour int
+	backoff      map[uuid.UUID]time.Time
+	stats        CatalogRefreshStats
+
+	cancel context.CancelFunc
+	stop   chan struct{}
+	done   chan struct{}
+}
+
+func NewCatalogRefreshWorker(
+	movieRepo domain.MovieRepository,
+	refresher infrastructure.MovieFetcher,
+	omdbQuota *infrastructure.OMDbQuotaTracker,
+	redis *infrastructure.RedisService,
+	interval time.Duration,
+	hourlyBudget int,
+	retryDelay time.Duration,
+) *CatalogRefreshWorker {
+	return &CatalogRefreshWorker{
+		movieRepo:    movieRepo,
+		refresher:    refresher,
+		omdbQuota:    omdbQuota,
+		redis:        redis,
+		lock:         infrastructure.NewDistributedLock(redis, catalogRefreshLockKey, catalogRefreshLockTTL),
+		interval:     interval,
+		hourlyBudget: hourlyBudget,
+		retryDelay:   retryDelay,
+		backoff:      map[uuid.UUID]time.Time{},
+	}
+}
+
+func (w *CatalogRefreshWorker) Start() {
+	if w.stop != nil || w.interval <= 0 || w.hourlyBudget <= 0 || w.refresher == nil {
+		return
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	w.cancel = cancel
+	w.stop = make(chan struct{})
+	w.done = make(chan struct{})
+
+	go func() {
+		defer close(w.done)
+
+		ticker := time.NewTicker(w.interval)
+		defer ticker.Stop()
+
+		w.refresh(ctx)
+		for {
+			select {
+			case <-ticker.C:
+				w.refresh(ctx)
+			case <-w.stop:
+				return
+			}
+		}
+	}()
+}
+
+// Stop ends the worker, cancelling the refresh in progress
+func (w *CatalogRefreshWorker) Stop() {
+	if w.stop == nil {
+		return
+	}
+
+	w.cancel()
+	close(w.stop)
+	<-w.done
+	w.stop = nil
+}
+
+// Stats returns a snapshot of the worker state
+func (w *CatalogRefreshWorker) Stats() CatalogRefreshStats {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	stats := w.stats
+	stats.Enabled = w.stop != nil
+	stats.Running = w.running
+	stats.Interval = w.interval
+	stats.HourlyBudget = w.hourlyBudget
+	stats.UsedThisHour = w.usedThisHour
+	stats.RetryDelay = w.retryDelay
+	stats.BackedOff = len(w.backoff)
+	return stats
+}
+
+func (w *CatalogRefreshWorker) refresh(ctx context.Context) {
+	started := time.Now()
+	if w.omdbQuota.Exhausted() {
+		w.finishRun(started, 0, 0, 0, errQuotaExhausted.Error())
+		return
+	}
+
+	acquired, err := w.lock.TryAcquire(ctx)
+	if err != nil {
+		log.Printf("[CatalogRefresh] Failed to acquire lock: %v", err)
+		w.finishRun(started, 0, 0, 0, err.Error())
+		return
+	}
+	if !acquired {
+		log.Printf("[CatalogRefresh] Another instance is refreshing the catalog")
+		w.finishRun(started, 0, 0, 0, "")
+		return
+	}
+	defer func() {
+		if err := w.lock.Release(context.WithoutCancel(ctx)); err != nil {
+			log.Printf("[CatalogRefresh] Failed to release lock: %v", err)
+		}
+	}()
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	go keepLock(ctx, w.lock, cancel, "CatalogRefresh")
+
+	batch, excluded := w.beginRun(ctx, started)
+	if batch == 0 {
+		w.finishRun(started, 0, 0, 0, "")
+		return
+	}
+
+	movies, err := w.movieRepo.GetExpiredMovies(ctx, batch, excluded)
+	if err != nil {
+		log.Printf("[CatalogRefresh] Failed to select expired movies: %v", err)
+		w.finishRun(started, 0, 0, 0, err.Error())
+		return
+	}
+
+	w.mu.Lock()
+	w.stats.CurrentRunTotal = len(movies)
+	w.stats.CurrentRunDone = 0
+	w.mu.Unlock()
+
+	refreshed, failed := 0, 0
+	lastError := ""
+	for _, movie := range movies {
+		select {
+		case <-w.stop:
+			w.finishRun(started, len(movies), refreshed, failed, lastError)
+			return
+		default:
+		}
+
+		// Movies left once the quota ran out would only be answered from the database
+		if w.omdbQuota.Exhausted() {
+			log.Printf("[CatalogRefresh] OMDb quota exhausted, stopping run")
+			lastError = errQuotaExhausted.Error()
+			break
+		}
+
+		err := w.refreshMovie(ctx, movie)
+		if ctx.Err() != nil {
+			break
+		}
+		w.consumeBudget(ctx, started)
+		if err != nil {
+			failed++
+			lastError = err.Error()
+			w.backOff(movie.ID)
+			log.Printf("[CatalogRefresh] Failed to refresh %s: %v", movie.ExternalAPIID, err)
+			continue
+		}
+		refreshed++
+	}
+
+	if len(movies) > 0 {
+		log.Printf("[CatalogRefresh] Refreshed %d of %d expired movies (%d failed)", refreshed, len(movies), failed)
+	}
+	w.finishRun(started, len(movies), refreshed, failed, lastError)
+}
+
+// refreshMovie fetches the movie again; the row only counts as refreshed once its expiration moved
+// forward, since the database link at the end of the chain answers with the stale row itself
+func (w *CatalogRefreshWorker) refreshMovie(ctx context.Context, movie *domain.Movie) error {
+	updated, err := w.refresher.FetchByExternalID(ctx, movie.ExternalAPIID)
+	if err != nil {
+		return err
+	}
+	if !updated.CacheExpiresAt.After(time.Now()) {
+		return errNotRefreshed
+	}
+
+	// Without auto-save the providers return the movie without storing it
+	if updated.ID == uuid.Nil {
+		updated.ID = movie.ID
+		updated.CreatedAt = movie.CreatedAt
+		return w.movieRepo.UpdateMovie(ctx, updated)
+	}
+	return nil
+}
+
+// beginRun computes how many movies this run may refresh and which ones are backed off
+func (w *CatalogRefreshWorker) beginRun(ctx context.Context, now time.Time) (int, []uuid.UUID) {
+	used, shared := w.sharedBudgetUse(ctx, now)
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.running = true
+	w.stats.LastRunStarted = &now
+
+	if hour := now.Truncate(time.Hour); !w.hourStart.Equal(hour) {
+		w.hourStart = hour
+		w.usedThisHour = 0
+	}
+	if shared {
+		w.usedThisHour = used
+	}
+
+	// Spread the hourly budget evenly over the runs of an hour
+	perRun := w.hourlyBudget
+	if runsPerHour := int(time.Hour / w.interval); runsPerHour > 1 {
+		perRun = (w.hourlyBudget + runsPerHour - 1) / runsPerHour
+	}
+	if remaining := w.hourlyBudget - w.usedThisHour; perRun > remaining {
+		perRun = remaining
+	}
+
+	excluded := make([]uuid.UUID, 0, len(w.backoff))
+	for id, until := range w.backoff {
+		if now.After(until) {
+			delete(w.backoff, id)
+			continue
+		}
+		excluded = append(excluded, id)
+	}
+
+	return perRun, excluded
+}
+
+// sharedBudgetUse reads the budget used this hour by every instance. Without Redis, or when it
+// fails, the local count is used.
+func (w *CatalogRefreshWorker) sharedBudgetUse(ctx context.Context, now time.Time) (int, bool) {
+	if w.redis == nil {
+		return 0, false
+	}
+
+	var used int
+	if err := w.redis.Get(ctx, catalogRefreshBudgetKey(now), &used); err != nil {
+		if err.Error() == "key not found" {
+			return 0, true
+		}
+		log.Printf("[CatalogRefresh] Failed to read budget use: %v", err)
+		return 0, false
+	}
+	return used, true
+}
+
+// consumeBudget counts a refreshed movie against the budget of the hour the run started in
+func (w *CatalogRefreshWorker) consumeBudget(ctx context.Context, started time.Time) {
+	used := 0
+	if w.redis != nil {
+		n, err := w.redis.Increment(context.WithoutCancel(ctx), catalogRefreshBudgetKey(started), time.Hour)
+		if err != nil {
+			log.Printf("[CatalogRefresh] Failed to count budget use: %v", err)
+		}
+		used = int(n)
+	}
+
+	w.mu.Lock()
+	if used > 0 {
+		w.usedThisHour = used
+	} else {
+		w.usedThisHour++
+	}
+	w.stats.CurrentRunDone++
+	w.mu.Unlock()
+}
+
+func catalogRefreshBudgetKey(t time.Time) string {
+	return catalogRefreshBudgetKeyPrefix + t.UTC().Format("2006010215")
+}
+
+func (w *CatalogRefreshWorker) backOff(id uuid.UUID) {
+	w.mu.Lock()
+	w.backoff[id] = time.Now().Add(w.retryDelay)
+	w.mu.Unlock()
+}
+
+func (w *CatalogRefreshWorker) finishRun(started time.Time, selected, refreshed, failed int, lastError string) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	finished := time.Now()
+	w.running = false
+	w.stats.CurrentRunTotal = 0
+	w.stats.CurrentRunDone = 0
+	w.stats.LastRunFinished = &finished
+	w.stats.LastRunDuration = finished.Sub(started)
+	w.stats.LastRunSelected = selected
+	w.stats.LastRunRefreshed = refreshed
+	w.stats.LastRunFailed = failed
+	w.stats.LastError = lastError
+	w.stats.TotalRefreshed += refreshed
+	w.stats.TotalFailed += failed
+}
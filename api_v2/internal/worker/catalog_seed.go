@@ -0,0 +1,293 @@
+package worker
+
+import (
+	"context"
+	"log"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/EduardoMG12/cine/api_v2/internal/data"
+	"github.com/EduardoMG12/cine/api_v2/internal/infrastructure"
+)
+
+const (
+	catalogSeedLockKey   = "catalog:seed:lock"
+	catalogSeedStatusKey = "catalog:seed:status"
+	catalogSeedLockTTL   = 2 * time.Minute
+	// The shared status is kept long enough to report the last run and enforce the cooldown
+	catalogSeedStatusTTL = 7 * 24 * time.Hour
+	// Progress is published to Redis every catalogSeedPublishEvery searches
+	catalogSeedPublishEvery = 10
+
+	CatalogSeedIdle      = "idle"
+	CatalogSeedRunning   = "running"
+	CatalogSeedCompleted = "completed"
+	CatalogSeedAborted   = "aborted"
+)
+
+// CatalogSeedStatus describes the current or last seeding run, on whichever instance ran it
+type CatalogSeedStatus struct {
+	State      string     `json:"state"`
+	StartedAt  *time.Time `json:"started_at,omitempty"`
+	FinishedAt *time.Time `json:"finished_at,omitempty"`
+	Total      int        `json:"total"`
+	Processed  int        `json:"processed"`
+	Found      int        `json:"found"`
+	Failed     int        `json:"failed"`
+	LastError  string     `json:"last_error,omitempty"`
+	UpdatedAt  time.Time  `json:"updated_at"`
+}
+
+// CatalogSeedJob populates an empty catalog by searching every title of data.MovieSeeds through the
+// movie chain, whose providers save the results. Runs are started on demand by Trigger, use a pool
+// of concurrency workers and hold a distributed lock so a single instance seeds at a time. A new run
+// is not started within cooldown of the previous one, whatever its outcome.
+type CatalogSeedJob struct {
+	fetcher     infrastructure.MovieFetcher
+	lock        *infrastructure.DistributedLock
+	redis       *infrastructure.RedisService
+	omdbQuota   *infrastructure.OMDbQuotaTracker
+	concurrency int
+	cooldown    time.Duration
+
+	mu      sync.Mutex
+	ctx     context.Context
+	cancel  context.CancelFunc
+	running bool
+	status  CatalogSeedStatus
+	wg      sync.WaitGroup
+}
+
+func NewCatalogSeedJob(
+	fetcher infrastructure.MovieFetcher,
+	redis *infrastructure.RedisService,
+	omdbQuota *infrastructure.OMDbQuotaTracker,
+	concurrency int,
+	cooldown time.Duration,
+) *CatalogSeedJob {
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	return &CatalogSeedJob{
+		fetcher:     fetcher,
+		lock:        infrastructure.NewDistributedLock(redis, catalogSeedLockKey, catalogSeedLockTTL),
+		redis:       redis,
+		omdbQuota:   omdbQuota,
+		concurrency: concurrency,
+		cooldown:    cooldown,
+		status:      CatalogSeedStatus{State: CatalogSeedIdle},
+	}
+}
+
+func (j *CatalogSeedJob) Start() {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	if j.ctx != nil {
+		return
+	}
+	j.ctx, j.cancel = context.WithCancel(context.Background())
+}
+
+// Stop cancels the run in progress and waits for it to end
+func (j *CatalogSeedJob) Stop() {
+	j.mu.Lock()
+	if j.ctx == nil {
+		j.mu.Unlock()
+		return
+	}
+	j.cancel()
+	j.ctx = nil
+	j.mu.Unlock()
+
+	j.wg.Wait()
+}
+
+// Trigger starts a run in the background unless one is running or the cooldown has not passed.
+// It never blocks; whether this instance gets to seed is decided by the distributed lock.
+func (j *CatalogSeedJob) Trigger(ctx context.Context) bool {
+	j.mu.Lock()
+	if j.ctx == nil || j.running {
+		j.mu.Unlock()
+		return false
+	}
+	runCtx := j.ctx
+	j.mu.Unlock()
+
+	// A running status that stopped being updated belongs to an instance that died mid-run
+	last := j.Status(ctx)
+	if last.State == CatalogSeedRunning && time.Since(last.UpdatedAt) < catalogSeedLockTTL {
+		return false
+	}
+	if last.FinishedAt != nil && time.Since(*last.FinishedAt) < j.cooldown {
+		return false
+	}
+
+	j.mu.Lock()
+	if j.running {
+		j.mu.Unlock()
+		return false
+	}
+	j.running = true
+	j.wg.Add(1)
+	j.mu.Unlock()
+
+	go func() {
+		defer j.wg.Done()
+		defer func() {
+			j.mu.Lock()
+			j.running = false
+			j.mu.Unlock()
+		}()
+		j.run(runCtx)
+	}()
+	return true
+}
+
+// Status returns the run in progress on this instance, or the last run published by any instance
+func (j *CatalogSeedJob) Status(ctx context.Context) CatalogSeedStatus {
+	j.mu.Lock()
+	local := j.status
+	running := j.running
+	j.mu.Unlock()
+
+	if running || j.redis == nil {
+		return local
+	}
+
+	var shared CatalogSeedStatus
+	if err := j.redis.Get(ctx, catalogSeedStatusKey, &shared); err != nil {
+		return local
+	}
+	return shared
+}
+
+func (j *CatalogSeedJob) run(ctx context.Context) {
+	acquired, err := j.lock.TryAcquire(ctx)
+	if err != nil {
+		log.Printf("[CatalogSeed] Failed to acquire lock: %v", err)
+		return
+	}
+	if !acquired {
+		log.Printf("[CatalogSeed] Another instance is seeding the catalog")
+		return
+	}
+	defer func() {
+		if err := j.lock.Release(context.WithoutCancel(ctx)); err != nil {
+			log.Printf("[CatalogSeed] Failed to release lock: %v", err)
+		}
+	}()
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	go keepLock(ctx, j.lock, cancel, "CatalogSeed")
+
+	seeds := seedTitles()
+	started := time.Now()
+	j.update(ctx, func(status *CatalogSeedStatus) {
+		*status = CatalogSeedStatus{State: CatalogSeedRunning, StartedAt: &started, Total: len(seeds)}
+	}, true)
+	log.Printf("[CatalogSeed] Seeding catalog with %d titles (%d workers)", len(seeds), j.concurrency)
+
+	titles := make(chan string)
+	var workers sync.WaitGroup
+	for i := 0; i < j.concurrency; i++ {
+		workers.Add(1)
+		go func() {
+			defer workers.Done()
+			for title := range titles {
+				j.seed(ctx, title)
+			}
+		}()
+	}
+
+	aborted := ""
+feed:
+	for _, title := range seeds {
+		if j.omdbQuota.Exhausted() {
+			aborted = errQuotaExhausted.Error()
+			break
+		}
+		select {
+		case titles <- title:
+		case <-ctx.Done():
+			aborted = "seeding cancelled"
+			break feed
+		}
+	}
+	close(titles)
+	workers.Wait()
+
+	finished := time.Now()
+	j.update(ctx, func(status *CatalogSeedStatus) {
+		status.State = CatalogSeedCompleted
+		if aborted != "" {
+			status.State = CatalogSeedAborted
+			status.LastError = aborted
+		}
+		status.FinishedAt = &finished
+	}, true)
+
+	status := j.Status(ctx)
+	log.Printf("[CatalogSeed] Seeding %s: %d processed, %d found, %d failed in %s",
+		status.State, status.Processed, status.Found, status.Failed, finished.Sub(started).Round(time.Second))
+}
+
+// seed searches a title; the providers save what they find
+func (j *CatalogSeedJob) seed(ctx context.Context, title string) {
+	movies, err := j.fetcher.Search(ctx, title, 1)
+	if ctx.Err() != nil {
+		return
+	}
+
+	j.update(ctx, func(status *CatalogSeedStatus) {
+		status.Processed++
+		switch {
+		case err != nil:
+			status.Failed++
+			status.LastError = err.Error()
+			log.Printf("[CatalogSeed] Search for %q failed: %v", title, err)
+		case len(movies) > 0:
+			status.Found++
+		}
+	}, false)
+}
+
+// update changes the local status and publishes it every few searches, or right away when publish is set
+func (j *CatalogSeedJob) update(ctx context.Context, change func(*CatalogSeedStatus), publish bool) {
+	j.mu.Lock()
+	change(&j.status)
+	j.status.UpdatedAt = time.Now()
+	status := j.status
+	j.mu.Unlock()
+
+	if j.redis == nil || !(publish || status.Processed%catalogSeedPublishEvery == 0) {
+		return
+	}
+	if err := j.redis.Set(context.WithoutCancel(ctx), catalogSeedStatusKey, status, catalogSeedStatusTTL); err != nil {
+		log.Printf("[CatalogSeed] Failed to publish status: %v", err)
+	}
+}
+
+// seedTitles lists the seed titles, categories in alphabetical order, without duplicates
+func seedTitles() []string {
+	categories := make([]string, 0, len(data.MovieSeeds))
+	for category := range data.MovieSeeds {
+		categories = append(categories, category)
+	}
+	sort.Strings(categories)
+
+	seen := map[string]bool{}
+	titles := []string{}
+	for _, category := range categories {
+		for _, seed := range data.MovieSeeds[category] {
+			if !seen[seed.Title] {
+				seen[seed.Title] = true
+				titles = append(titles, seed.Title)
+			}
+		}
+	}
+	return titles
+}
@@ -0,0 +1,33 @@
+package worker
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/EduardoMG12/cine/api_v2/internal/infrastructure"
+)
+
+// keepLock refreshes the lock while the run lasts and cancels the run if the lock is lost
+func keepLock(ctx context.Context, lock *infrastructure.DistributedLock, cancel context.CancelFunc, component string) {
+	ticker := time.NewTicker(lock.TTL() / 3)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			held, err := lock.Refresh(ctx)
+			if err != nil && ctx.Err() == nil {
+				log.Printf("[%s] Failed to refresh lock: %v", component, err)
+				continue
+			}
+			if !held && ctx.Err() == nil {
+				log.Printf("[%s] Lock lost, stopping", component)
+				cancel()
+				return
+			}
+		}
+	}
+}
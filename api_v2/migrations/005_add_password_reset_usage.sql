@@ -0,0 +1,6 @@
+-- Migration to make password reset tokens single use
+-- Date: 2026-10-16
+
+-- The token column stores the SHA-256 hash of the token sent by email
+ALTER TABLE password_reset_tokens
+ADD COLUMN IF NOT EXISTS used_at TIMESTAMP WITH TIME ZONE;
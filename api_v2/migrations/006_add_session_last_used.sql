@@ -0,0 +1,6 @@
+-- Migration to track session activity
+-- Date: 2026-10-16
+
+-- Add last_used_at to show when a device was last active
+ALTER TABLE user_sessions
+ADD COLUMN IF NOT EXISTS last_used_at TIMESTAMP WITH TIME ZONE;
@@ -0,0 +1,19 @@
+-- Migration to record login lockouts
+-- Date: 2026-10-16
+
+-- Failure counters live in Redis (or memory); only the lockouts they trigger are kept for review.
+-- scope is either 'email' or 'ip' and identifier holds the locked email or IP address.
+CREATE TABLE IF NOT EXISTS login_lockouts (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    scope VARCHAR(10) NOT NULL,
+    identifier VARCHAR(255) NOT NULL,
+    failures INTEGER NOT NULL,
+    ip_address INET,
+    user_agent TEXT,
+    locked_until TIMESTAMP WITH TIME ZONE NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+);
+
+-- Login lockouts indexes
+CREATE INDEX IF NOT EXISTS idx_login_lockouts_identifier ON login_lockouts(scope, identifier);
+CREATE INDEX IF NOT EXISTS idx_login_lockouts_created_at ON login_lockouts(created_at);
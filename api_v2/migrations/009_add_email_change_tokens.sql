@@ -0,0 +1,19 @@
+-- Migration to add email change confirmation tokens
+-- Date: 2026-10-16
+
+-- An email change only applies once the new address is confirmed with the token sent to it.
+-- session_id remembers the session that asked for the change so it survives revoke_other_sessions.
+CREATE TABLE IF NOT EXISTS email_change_tokens (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    session_id UUID REFERENCES user_sessions(id) ON DELETE SET NULL,
+    new_email VARCHAR(255) NOT NULL,
+    token_hash VARCHAR(64) NOT NULL UNIQUE,
+    revoke_other_sessions BOOLEAN NOT NULL DEFAULT FALSE,
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+);
+
+-- Email change tokens indexes
+CREATE INDEX IF NOT EXISTS idx_email_change_tokens_user_id ON email_change_tokens(user_id);
+CREATE INDEX IF NOT EXISTS idx_email_change_tokens_expires_at ON email_change_tokens(expires_at);
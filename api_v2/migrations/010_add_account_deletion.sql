@@ -0,0 +1,10 @@
+-- Migration to schedule account deletion
+-- Date: 2026-10-16
+
+-- Deleting an account only schedules it; the row is removed (cascading to every
+-- user-owned table) once the grace period ends. Logging in again cancels the deletion.
+ALTER TABLE users
+ADD COLUMN IF NOT EXISTS deletion_scheduled_at TIMESTAMP WITH TIME ZONE;
+
+CREATE INDEX IF NOT EXISTS idx_users_deletion_scheduled_at ON users(deletion_scheduled_at)
+WHERE deletion_scheduled_at IS NOT NULL;
@@ -0,0 +1,11 @@
+-- Migration to add user roles and disabled accounts
+-- Date: 2026-10-16
+
+-- Every account is a regular user unless promoted; the first admin is created with
+-- the bootstrap-admin command. Disabled accounts can't log in or use existing tokens.
+ALTER TABLE users
+ADD COLUMN IF NOT EXISTS role VARCHAR(20) NOT NULL DEFAULT 'user'
+    CHECK (role IN ('user', 'moderator', 'admin')),
+ADD COLUMN IF NOT EXISTS disabled_at TIMESTAMP WITH TIME ZONE;
+
+CREATE INDEX IF NOT EXISTS idx_users_role ON users(role) WHERE role <> 'user';
@@ -0,0 +1,23 @@
+-- Migration to support user search and username changes
+-- Date: 2026-10-16
+
+-- Trigram indexes back the fuzzy user search
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+CREATE INDEX IF NOT EXISTS idx_users_username_trgm ON users USING GIN (username gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS idx_users_display_name_trgm ON users USING GIN (LOWER(display_name) gin_trgm_ops);
+
+-- Username changes are rate limited
+ALTER TABLE users
+ADD COLUMN IF NOT EXISTS username_changed_at TIMESTAMP WITH TIME ZONE;
+
+-- A username given up by a user stays reserved for them for a while, so nobody can
+-- impersonate them right after a rename and they can still switch back.
+CREATE TABLE IF NOT EXISTS username_reservations (
+    username VARCHAR(30) PRIMARY KEY,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    reserved_until TIMESTAMP WITH TIME ZONE NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_username_reservations_user_id ON username_reservations(user_id);
@@ -0,0 +1,40 @@
+-- Migration to store movie credits, languages, countries, awards, box office and external ratings
+-- Date: 2026-10-16
+
+ALTER TABLE movies
+ADD COLUMN IF NOT EXISTS languages TEXT[],
+ADD COLUMN IF NOT EXISTS countries TEXT[],
+ADD COLUMN IF NOT EXISTS awards TEXT, -- summary as given by the provider
+ADD COLUMN IF NOT EXISTS award_wins INTEGER,
+ADD COLUMN IF NOT EXISTS award_nominations INTEGER,
+ADD COLUMN IF NOT EXISTS box_office BIGINT; -- US dollars
+
+-- Providers give no stable ID for people, so they are shared between movies by name
+CREATE TABLE IF NOT EXISTS people (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    name VARCHAR(255) NOT NULL UNIQUE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+);
+
+CREATE TABLE IF NOT EXISTS movie_credits (
+    movie_id UUID NOT NULL REFERENCES movies(id) ON DELETE CASCADE,
+    person_id UUID NOT NULL REFERENCES people(id) ON DELETE CASCADE,
+    role VARCHAR(20) NOT NULL CHECK (role IN ('director', 'writer', 'actor')),
+    character_name VARCHAR(255),
+    billing_order INTEGER NOT NULL DEFAULT 0,
+    PRIMARY KEY (movie_id, person_id, role)
+);
+
+CREATE INDEX IF NOT EXISTS idx_movie_credits_person_id ON movie_credits(person_id);
+
+-- One rating per source; score is the rating on a 0-100 scale so sources can be compared
+CREATE TABLE IF NOT EXISTS movie_ratings (
+    movie_id UUID NOT NULL REFERENCES movies(id) ON DELETE CASCADE,
+    source VARCHAR(30) NOT NULL CHECK (source IN ('imdb', 'rotten_tomatoes', 'metacritic', 'tmdb')),
+    value VARCHAR(50) NOT NULL,
+    score DECIMAL(5,2),
+    votes INTEGER,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    PRIMARY KEY (movie_id, source)
+);
@@ -0,0 +1,6 @@
+-- Migration to support people search
+-- Date: 2026-10-16
+
+-- Trigram index backs the fuzzy people search (pg_trgm is enabled by 013)
+CREATE INDEX IF NOT EXISTS idx_people_name_trgm ON people USING GIN (LOWER(name) gin_trgm_ops);
+
@@ -0,0 +1,47 @@
+-- Migration to add TV series, seasons, episodes and watched episodes
+-- Date: 2026-10-16
+
+-- Series are stored with the movies, which keeps search, favorites and reviews working for both
+ALTER TABLE movies
+ADD COLUMN IF NOT EXISTS title_type VARCHAR(20) NOT NULL DEFAULT 'movie'
+    CHECK (title_type IN ('movie', 'series', 'episode')),
+ADD COLUMN IF NOT EXISTS total_seasons INTEGER;
+
+CREATE INDEX IF NOT EXISTS idx_movies_title_type ON movies(title_type) WHERE title_type <> 'movie';
+
+-- A season is synced from OMDb as a whole, so its expiration lives on the season
+CREATE TABLE IF NOT EXISTS series_seasons (
+    series_id UUID NOT NULL REFERENCES movies(id) ON DELETE CASCADE,
+    season_number INTEGER NOT NULL,
+    episode_count INTEGER NOT NULL DEFAULT 0,
+    last_sync_at TIMESTAMP WITH TIME ZONE,
+    cache_expires_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    PRIMARY KEY (series_id, season_number)
+);
+
+-- Episodes keep their ID across syncs so watched episodes stay linked
+CREATE TABLE IF NOT EXISTS episodes (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    series_id UUID NOT NULL,
+    season_number INTEGER NOT NULL,
+    episode_number INTEGER NOT NULL,
+    external_api_id VARCHAR(50), -- IMDb ID
+    title VARCHAR(500) NOT NULL,
+    release_date DATE,
+    imdb_rating DECIMAL(3,1),
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    FOREIGN KEY (series_id, season_number) REFERENCES series_seasons(series_id, season_number) ON DELETE CASCADE,
+    UNIQUE (series_id, season_number, episode_number)
+);
+
+CREATE TABLE IF NOT EXISTS watched_episodes (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    episode_id UUID NOT NULL REFERENCES episodes(id) ON DELETE CASCADE,
+    watched_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE(user_id, episode_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_watched_episodes_episode_id ON watched_episodes(episode_id);
@@ -0,0 +1,8 @@
+-- Migration to keep the TMDb ID of movies fetched from TMDb
+-- Date: 2026-10-16
+
+-- Movies are stored under their IMDb ID; the TMDb ID lets "tmdb:<id>" lookups find them
+ALTER TABLE movies
+ADD COLUMN IF NOT EXISTS tmdb_id INTEGER;
+
+CREATE INDEX IF NOT EXISTS idx_movies_tmdb_id ON movies(tmdb_id) WHERE tmdb_id IS NOT NULL;